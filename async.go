@@ -50,12 +50,42 @@ func SubmitDelegateVoid(provider corectx.ConcurrentContextProvider, fun generic.
 	return runtime.Concurrent(provider).SubmitDelegateVoid(fun, args...)
 }
 
+// SubmitTyped 将返回 (T, error) 的函数投递到 provider 所属 Runtime，并返回类型化结果 Future。
+func SubmitTyped[T any](provider corectx.ConcurrentContextProvider, fun func(ctx runtime.Context) (T, error)) async.TypedFuture[T] {
+	if fun == nil {
+		exception.Panicf("%w: %w: fun is nil", ErrCore, ErrArgs)
+	}
+	return async.AsTyped[T](runtime.Concurrent(provider).Submit(func(ctx runtime.Context, _ ...any) async.Result {
+		return async.NewResult(fun(ctx))
+	}))
+}
+
 // Post 将无返回值函数投递到 provider 所属 Runtime，不创建 Future。
 // 仅返回队列关闭、容量不足等同步入队错误。
 func Post(provider corectx.ConcurrentContextProvider, fun generic.ActionVar1[runtime.Context, any], args ...any) error {
 	return runtime.Concurrent(provider).Post(fun, args...)
 }
 
+// SubmitContext 与 Submit 相同；目标任务队列使用 TaskQueueOverflow_Block 策略且已满时，最多等待到 ctx 结束。
+func SubmitContext(ctx context.Context, provider corectx.ConcurrentContextProvider, fun generic.FuncVar1[runtime.Context, any, async.Result], args ...any) async.Future {
+	rtCtx := runtime.Concurrent(provider)
+	rt, ok := concurrentRuntime(rtCtx)
+	if !ok {
+		return rtCtx.Submit(fun, args...)
+	}
+	return rt.getTaskQueue().enqueueSubmit(ctx, rtCtx.ExecutorID(), fun, nil, nil, nil, args)
+}
+
+// PostContext 与 Post 相同；目标任务队列使用 TaskQueueOverflow_Block 策略且已满时，最多等待到 ctx 结束。
+func PostContext(ctx context.Context, provider corectx.ConcurrentContextProvider, fun generic.ActionVar1[runtime.Context, any], args ...any) error {
+	rtCtx := runtime.Concurrent(provider)
+	rt, ok := concurrentRuntime(rtCtx)
+	if !ok {
+		return rtCtx.Post(fun, args...)
+	}
+	return rt.getTaskQueue().enqueuePost(ctx, fun, nil, args)
+}
+
 // PostDelegate 将无返回值委托投递到 provider 所属 Runtime，不创建 Future。
 func PostDelegate(provider corectx.ConcurrentContextProvider, fun generic.DelegateVoidVar1[runtime.Context, any], args ...any) error {
 	return runtime.Concurrent(provider).PostDelegate(fun, args...)
@@ -82,7 +112,21 @@ func SpawnVoid(provider corectx.AsyncScopeProvider, fun generic.ActionVar1[conte
 	})
 }
 
+// Retry 在 provider 的生命周期 Scope 中启动后台任务，失败时按 policy 退避重试。
+// provider 关闭时停止重试；fun 不得直接访问 Runtime 局部状态。
+func Retry(provider corectx.AsyncScopeProvider, policy async.RetryPolicy, fun generic.FuncVar1[context.Context, any, async.Result], args ...any) async.Future {
+	if provider == nil {
+		exception.Panicf("%w: %w: provider is nil", ErrCore, ErrArgs)
+	}
+	return async.Retry(provider.AsyncScope(), policy, func(ctx context.Context) async.Result {
+		return fun.UnsafeCall(ctx, args...)
+	})
+}
+
 // After 在 dur 后以当前时间完成 Future；ctx 取消时以 ctx.Err 完成。
+//
+// ctx 为运行中的 runtime.Context 时，由运行时时间轮驱动，Future 在 Runtime goroutine 中完成，精度为 TimerTick；
+// 运行时终止时未到期的 Future 以终止原因完成。
 func After(ctx context.Context, dur time.Duration) async.Future {
 	if ctx == nil {
 		ctx = context.Background()
@@ -90,6 +134,12 @@ func After(ctx context.Context, dur time.Duration) async.Future {
 	if dur < 0 {
 		dur = 0
 	}
+	if rtCtx, s := lookupTimerService(ctx); s != nil {
+		promise, future := async.NewPromise(rtCtx.ExecutorID())
+		if s.after(promise, dur) {
+			return future
+		}
+	}
 	promise, future := async.NewPromise()
 	timer := time.AfterFunc(dur, func() {
 		promise.Resolve(async.NewResult(time.Now(), nil))
@@ -110,6 +160,10 @@ func At(ctx context.Context, at time.Time) async.Future {
 }
 
 // Every 按 dur 周期持续产出当前时间，直到 ctx 取消。
+//
+// ctx 为其他 Context 时，由独立 goroutine 驱动，产出时阻塞等待消费者，积压期间由 time.Ticker 合并错过的 tick。
+// ctx 为运行中的 runtime.Context 时，由运行时时间轮驱动，Runtime goroutine 不会等待消费者：Stream 只缓存 1 个
+// 未读取的 tick，消费者跟不上时丢弃新到期的 tick，因此积压的 tick 比独立实现更少；运行时终止时关闭 Stream。
 func Every(ctx context.Context, dur time.Duration) async.Stream {
 	if ctx == nil {
 		ctx = context.Background()
@@ -117,6 +171,12 @@ func Every(ctx context.Context, dur time.Duration) async.Stream {
 	if dur <= 0 {
 		exception.Panicf("%w: %w: duration must be positive", ErrCore, ErrArgs)
 	}
+	if _, s := lookupTimerService(ctx); s != nil {
+		emitter, stream := async.NewStream(1)
+		if s.every(emitter, dur) {
+			return stream
+		}
+	}
 	emitter, stream := async.NewStream()
 	go func() {
 		defer emitter.Close()
@@ -160,3 +220,8 @@ func FromChan[T any](ctx context.Context, ch <-chan T) async.Stream {
 	}()
 	return stream
 }
+
+func concurrentRuntime(rtCtx runtime.ConcurrentContext) (Runtime, bool) {
+	rt, ok := runtime.UnsafeContext(runtime.UnsafeConcurrentContext(rtCtx).Instance()).Caller().(Runtime)
+	return rt, ok
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"fmt"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// CallComponent 将 fn 投递到 entity 所属 Runtime，以类型 C 调用名为 name 的组件，并以 fn 的返回值完成 Future。
+// 实体失活或已被回收时以 ErrEntityDead 完成；组件正在移除、已销毁，或实体原型声明的同名内建组件已被移除时
+// 以 ErrComponentRemoved 完成，其余找不到组件的情况以 ErrComponentNotFound 完成；组件无法断言为 C 时以
+// ErrComponentTypeMismatch 完成；fn 的 panic 按 Submit 的方式处理。
+// 查找组件不会触发首次访问 Awake，启用 ComponentAwakeOnFirstTouch 时 fn 可能收到尚未 Awake 的组件。
+func CallComponent[C, R any](entity ec.ConcurrentEntity, name string, fn func(C) R) async.Future {
+	if fn == nil {
+		exception.Panicf("%w: %w: fn is nil", ErrCore, ErrArgs)
+	}
+
+	id, incarnation, err := checkCallingEntity(entity)
+	if err != nil {
+		return async.Rejected(err)
+	}
+
+	return runtime.Concurrent(entity).Submit(func(runtime.Context, ...any) async.Result {
+		comp, err := lookupCallingComponent[C](entity, id, incarnation, name)
+		if err != nil {
+			return async.NewResult(nil, err)
+		}
+		return async.NewResult(fn(comp), nil)
+	})
+}
+
+// CallComponentVoid 是 CallComponent 的无返回值版本，Future 只报告查找、断言与执行错误。
+func CallComponentVoid[C any](entity ec.ConcurrentEntity, name string, fn func(C)) async.Future {
+	if fn == nil {
+		exception.Panicf("%w: %w: fn is nil", ErrCore, ErrArgs)
+	}
+
+	id, incarnation, err := checkCallingEntity(entity)
+	if err != nil {
+		return async.Rejected(err)
+	}
+
+	return runtime.Concurrent(entity).Submit(func(runtime.Context, ...any) async.Result {
+		comp, err := lookupCallingComponent[C](entity, id, incarnation, name)
+		if err != nil {
+			return async.NewResult(nil, err)
+		}
+		fn(comp)
+		return async.NewResult(nil, nil)
+	})
+}
+
+// PostComponent 将 fn 投递到 entity 所属 Runtime，以类型 C 调用名为 name 的组件，不创建 Future。
+// 仅返回实体已失活与入队错误；执行时组件查找或断言失败会静默丢弃任务。
+func PostComponent[C any](entity ec.ConcurrentEntity, name string, fn func(C)) error {
+	if fn == nil {
+		exception.Panicf("%w: %w: fn is nil", ErrCore, ErrArgs)
+	}
+
+	id, incarnation, err := checkCallingEntity(entity)
+	if err != nil {
+		return err
+	}
+
+	return runtime.Concurrent(entity).Post(func(runtime.Context, ...any) {
+		comp, err := lookupCallingComponent[C](entity, id, incarnation, name)
+		if err != nil {
+			return
+		}
+		fn(comp)
+	})
+}
+
+func checkCallingEntity(entity ec.ConcurrentEntity) (uid.ID, uint64, error) {
+	if entity == nil {
+		exception.Panicf("%w: %w: entity is nil", ErrCore, ErrArgs)
+	}
+
+	id, incarnation := entity.ID(), ec.UnsafeConcurrentEntity(entity).Incarnation()
+
+	select {
+	case <-entity.Done():
+		return id, incarnation, fmt.Errorf("%w: entity %q", ErrEntityDead, id)
+	default:
+	}
+
+	return id, incarnation, nil
+}
+
+func lookupCallingComponent[C any](concurrent ec.ConcurrentEntity, id uid.ID, incarnation uint64, name string) (C, error) {
+	var zero C
+
+	entity := ec.UnsafeConcurrentEntity(concurrent).Instance()
+	if entity.State() > ec.EntityState_Alive || ec.UnsafeConcurrentEntity(entity).Incarnation() != incarnation {
+		return zero, fmt.Errorf("%w: entity %q", ErrEntityDead, id)
+	}
+
+	comp := ec.UnsafeEntity(entity).PeekComponent(name)
+	if (comp != nil && comp.State() > ec.ComponentState_Alive) || (comp == nil && declaresBuiltin(entity.PT(), name)) {
+		return zero, fmt.Errorf("%w: entity %q component %q", ErrComponentRemoved, id, name)
+	}
+	if comp == nil {
+		return zero, fmt.Errorf("%w: entity %q component %q", ErrComponentNotFound, id, name)
+	}
+
+	c, ok := comp.(C)
+	if !ok {
+		return zero, fmt.Errorf("%w: entity %q component %q is %T, not %T", ErrComponentTypeMismatch, id, name, comp, zero)
+	}
+
+	return c, nil
+}
+
+// declaresBuiltin 报告实体原型是否声明了名为 name 的内建组件。
+func declaresBuiltin(entityPT ec.EntityPT, name string) bool {
+	for i := range entityPT.CountComponents() {
+		if entityPT.GetComponent(i).Name == name {
+			return true
+		}
+	}
+	return false
+}
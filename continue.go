@@ -123,6 +123,22 @@ func ContinueOnDelegateVoid(
 	}, args...)
 }
 
+// ContinueOnTyped 是 ContinueOn 的类型化版本：future 完成后在 provider 所属 Runtime 中以其值与错误调用 fun，
+// 并以 fun 的返回值完成类型化 Future。Scope、入队与 panic 错误的处理同 ContinueOn。
+func ContinueOnTyped[T, R any](
+	provider corectx.ConcurrentContextProvider,
+	future async.TypedFuture[T],
+	fun func(ctx runtime.Context, value T, err error) (R, error),
+) async.TypedFuture[R] {
+	if fun == nil {
+		exception.Panicf("%w: %w: continuation is nil", ErrCore, ErrArgs)
+	}
+	return async.AsTyped[R](ContinueOn(provider, future.Future(), func(ctx runtime.Context, _ async.Result, _ ...any) async.Result {
+		value, err, _ := future.TryGet()
+		return async.NewResult(fun(ctx, value, err))
+	}))
+}
+
 func continuationScope(provider corectx.ConcurrentContextProvider, rt runtime.ConcurrentContext) *async.Scope {
 	if scoped, ok := provider.(corectx.AsyncScopeProvider); ok {
 		if scope := scoped.AsyncScope(); scope != nil {
@@ -22,8 +22,12 @@
 package core_test
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -32,6 +36,8 @@ import (
 	"git.golaxy.org/core/ec"
 	"git.golaxy.org/core/runtime"
 	"git.golaxy.org/core/service"
+	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/option"
 )
 
 const stressEntityBatchSize = int64(200)
@@ -171,3 +177,195 @@ func Test_CreateEntityStressFrameUpdate(t *testing.T) {
 		updates,
 	)
 }
+
+func Test_RingTaskQueueStressFIFO(t *testing.T) {
+	const (
+		producers = 16
+		perWorker = 20000
+	)
+
+	settings := []option.Setting[core.RuntimeOptions]{
+		core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+		core.With.Runtime.TaskQueue(
+			core.With.TaskQueue.Backend(core.TaskQueueBackend_Ring),
+			core.With.TaskQueue.Capacity(2),
+			core.With.TaskQueue.BatchSize(64),
+		),
+	}
+	runTaskQueueScenario(t, settings, func(rtCtx runtime.Context) error {
+		var received [producers][]int
+		var wg sync.WaitGroup
+		for p := range producers {
+			wg.Go(func() {
+				for i := range perWorker {
+					if err := core.Post(rtCtx, func(runtime.Context, ...any) { received[p] = append(received[p], i) }); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+			})
+		}
+		wg.Wait()
+
+		return core.Submit(rtCtx, func(runtime.Context, ...any) async.Result {
+			for p := range received {
+				if len(received[p]) != perWorker || !slices.IsSorted(received[p]) {
+					return async.NewResult(nil, fmt.Errorf("producer %d: received %d tasks out of order", p, len(received[p])))
+				}
+			}
+			return async.Result{}
+		}).Wait(context.Background()).Error
+	})
+}
+
+// startBenchmarkRuntime 启动一个无帧运行时，返回其上下文与停止函数。
+func startBenchmarkRuntime(b *testing.B, settings ...option.Setting[core.RuntimeOptions]) (runtime.Context, func()) {
+	b.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan runtime.Context, 1)
+
+	svcCtx := service.NewContext(
+		service.With.Context(ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			if runningEvent != service.RunningEvent_Started {
+				return
+			}
+			core.NewRuntime(
+				runtime.NewContext(ctx,
+					runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+						if runningEvent == runtime.RunningEvent_Started {
+							started <- rtCtx
+						}
+					}),
+				),
+				append([]option.Setting[core.RuntimeOptions]{
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				}, settings...)...,
+			)
+		}),
+	)
+	stopped := core.NewService(svcCtx).Run()
+
+	select {
+	case rtCtx := <-started:
+		return rtCtx, func() {
+			cancel()
+			<-stopped.Done()
+		}
+	case <-time.After(5 * time.Second):
+		cancel()
+		b.Fatal("benchmark runtime did not start")
+		return nil, nil
+	}
+}
+
+func Benchmark_AfterSchedule_Standalone(b *testing.B) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		core.After(ctx, time.Hour)
+	}
+}
+
+func Benchmark_AfterSchedule_TimingWheel(b *testing.B) {
+	rtCtx, stop := startBenchmarkRuntime(b)
+	defer stop()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		core.After(rtCtx, time.Hour)
+	}
+}
+
+func benchmarkAfterExpire(b *testing.B, ctx context.Context) {
+	const batch = 1000
+	futures := make([]async.Future, batch)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for i := range futures {
+			futures[i] = core.After(ctx, time.Millisecond)
+		}
+		for _, future := range futures {
+			future.Wait(context.Background())
+		}
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*batch), "ns/timer")
+}
+
+func Benchmark_AfterExpire_Standalone(b *testing.B) {
+	benchmarkAfterExpire(b, context.Background())
+}
+
+func Benchmark_AfterExpire_TimingWheel(b *testing.B) {
+	rtCtx, stop := startBenchmarkRuntime(b)
+	defer stop()
+
+	benchmarkAfterExpire(b, rtCtx)
+}
+
+func benchmarkPostBatch(b *testing.B, settings ...option.Setting[core.TaskQueueOptions]) {
+	rtCtx, stop := startBenchmarkRuntime(b, core.With.Runtime.TaskQueue(settings...))
+	defer stop()
+
+	const producers = 4
+	var executed atomic.Int64
+	task := func(runtime.Context, ...any) { executed.Add(1) }
+
+	b.ReportAllocs()
+	b.SetParallelism(producers)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			err := core.Post(rtCtx, task)
+			for errors.Is(err, core.ErrTaskQueueFull) {
+				err = core.Post(rtCtx, task)
+			}
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+	core.Submit(rtCtx, func(runtime.Context, ...any) async.Result { return async.Result{} }).Wait(context.Background())
+
+	stats := runtime.UnsafeContext(rtCtx).Caller().(core.Runtime).Stats().Batches
+	if stats.Batches > 0 {
+		b.ReportMetric(float64(stats.Tasks)/float64(stats.Batches), "tasks/batch")
+	}
+}
+
+func Benchmark_PostBatch_1(b *testing.B) {
+	benchmarkPostBatch(b)
+}
+
+func Benchmark_PostBatch_64(b *testing.B) {
+	benchmarkPostBatch(b, core.With.TaskQueue.BatchSize(64))
+}
+
+func Benchmark_PostBatch_64Events(b *testing.B) {
+	benchmarkPostBatch(b, core.With.TaskQueue.BatchSize(64), core.With.TaskQueue.BatchEvents(true))
+}
+
+func Benchmark_PostBatch_Bounded_1(b *testing.B) {
+	benchmarkPostBatch(b, core.With.TaskQueue.Unbounded(false), core.With.TaskQueue.Capacity(4096))
+}
+
+func Benchmark_PostBatch_Bounded_64(b *testing.B) {
+	benchmarkPostBatch(b, core.With.TaskQueue.Unbounded(false), core.With.TaskQueue.Capacity(4096), core.With.TaskQueue.BatchSize(64))
+}
+
+func Benchmark_PostRing_1(b *testing.B) {
+	benchmarkPostBatch(b, core.With.TaskQueue.Backend(core.TaskQueueBackend_Ring))
+}
+
+func Benchmark_PostRing_64(b *testing.B) {
+	benchmarkPostBatch(b, core.With.TaskQueue.Backend(core.TaskQueueBackend_Ring), core.With.TaskQueue.BatchSize(64))
+}
+
+func Benchmark_PostRing_Bounded_64(b *testing.B) {
+	benchmarkPostBatch(b, core.With.TaskQueue.Backend(core.TaskQueueBackend_Ring), core.With.TaskQueue.Unbounded(false), core.With.TaskQueue.Capacity(4096), core.With.TaskQueue.BatchSize(64))
+}
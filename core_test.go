@@ -20,16 +20,26 @@
 package core_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"log/slog"
+	"net"
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"git.golaxy.org/core/utils/assertion"
+	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/generic"
+	"git.golaxy.org/core/utils/option"
 	"git.golaxy.org/core/utils/uid"
 	"github.com/elliotchance/pie/v2"
 
@@ -40,6 +50,7 @@ import (
 	"git.golaxy.org/core/extension"
 	"git.golaxy.org/core/runtime"
 	"git.golaxy.org/core/service"
+	"git.golaxy.org/core/service/remotedir"
 )
 
 type coreTestScenario struct {
@@ -292,6 +303,88 @@ func Test_ServiceRegisterEntityPT(t *testing.T) {
 	scenario.run(t, svcCtx)
 }
 
+type ComponentTestLoad struct {
+	ec.ComponentBehavior
+	Speed int
+	Tag   string `json:"tag"`
+}
+
+func Test_LoadEntityPT(t *testing.T) {
+	compLib := pt.NewComponentLib()
+	test1PT := compLib.Declare(ComponentTest1{})
+	loadPT := compLib.Declare(ComponentTestLoad{})
+	entityLib := pt.NewEntityLib(compLib)
+
+	loader := pt.NewLoader(entityLib).RegisterInstance(EntityTest1{})
+
+	entityPTs, err := loader.Load("entities.yaml", []byte(`
+- prototype: Loaded1
+  instance: core_test.EntityTest1
+  scope: local
+  component_awake_on_first_touch: true
+  indexed_meta: [account_id, level]
+  meta: {level: 3}
+  components:
+    - `+test1PT.Prototype()+`
+    - pt: {prototype: "`+loadPT.Prototype()+`"}
+      name: Mover
+      removable: true
+      meta: {kind: fast}
+      fields: {Speed: 7, tag: hero}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entityPTs) != 1 {
+		t.Fatalf("loaded entity prototype count: got %d, want 1", len(entityPTs))
+	}
+
+	entityPT := entityPTs[0]
+	if entityPT.Scope() != ec.Scope_Local || !entityPT.ComponentAwakeOnFirstTouch() || entityPT.InstanceRT() == nil {
+		t.Fatalf("unexpected loaded entity prototype: %s", entityPT)
+	}
+	if got := entityPT.IndexedMeta(); !slices.Equal(got, []string{"account_id", "level"}) {
+		t.Fatalf("entity indexed meta: got %v, want [account_id level]", got)
+	}
+	if v, _ := entityPT.Meta().Get("level"); v != 3 {
+		t.Fatalf("entity meta level: got %v, want 3", v)
+	}
+	builtin := entityPT.GetComponent(1)
+	if builtin.Name != "Mover" || !builtin.Removable || builtin.PT != loadPT {
+		t.Fatalf("unexpected loaded builtin component: %s", builtin)
+	}
+
+	comp, ok := entityPT.Construct().GetComponent("Mover").(*ComponentTestLoad)
+	if !ok {
+		t.Fatal("loaded builtin component Mover was not constructed")
+	}
+	if comp.Speed != 7 || comp.Tag != "hero" {
+		t.Fatalf("component fields: got Speed=%d Tag=%q, want Speed=7 Tag=%q", comp.Speed, comp.Tag, "hero")
+	}
+
+	for _, c := range []struct {
+		doc  string
+		line int
+	}{
+		{doc: "{\n  \"prototype\": \"Bad1\",\n  \"components\": [\n    \"NotDeclared\"\n  ]\n}", line: 4},
+		{doc: "prototype: Bad2\ncomponents:\n  - pt: " + loadPT.Prototype() + "\n    fields:\n      Unknown: 1\n", line: 5},
+		{doc: "prototype: Bad3\nscope: nowhere\n", line: 2},
+		{doc: "prototype: Bad4\ncomponents: [\n", line: 2},
+	} {
+		_, err := loader.Load("bad.json", []byte(c.doc))
+		var loadErr *pt.LoadError
+		if !errors.As(err, &loadErr) || !errors.Is(err, pt.ErrLoad) {
+			t.Fatalf("load %q: got %v, want LoadError", c.doc, err)
+		}
+		if loadErr.Line != c.line {
+			t.Fatalf("load %q: error line got %d, want %d (%s)", c.doc, loadErr.Line, c.line, err)
+		}
+	}
+	if got := len(entityLib.List()); got != 1 {
+		t.Fatalf("entity prototype count after failed loads: got %d, want 1", got)
+	}
+}
+
 func Test_CreateEntity(t *testing.T) {
 	scenario := newCoreTestScenario(3 * time.Second)
 	var entities []ec.Entity
@@ -337,27 +430,3013 @@ func Test_CreateEntity(t *testing.T) {
 									scenario.complete(fmt.Errorf("entity %q component count: got %d, want %d", prototype, got, componentCount))
 									return
 								}
-								entities = append(entities, entity)
-							}
-							if got := ctx.EntityManager().CountEntities(); got != len(entities) {
-								scenario.complete(fmt.Errorf("runtime entity count: got %d, want %d", got, len(entities)))
-								return
+								entities = append(entities, entity)
+							}
+							if got := ctx.EntityManager().CountEntities(); got != len(entities) {
+								scenario.complete(fmt.Errorf("runtime entity count: got %d, want %d", got, len(entities)))
+								return
+							}
+							scenario.complete(nil)
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+	for _, entity := range entities {
+		if entity.State() != ec.EntityState_Destroyed {
+			t.Errorf("entity %s state after shutdown: got %s, want %s", entity.ID(), entity.State(), ec.EntityState_Destroyed)
+		}
+	}
+}
+
+type ComponentTestMeta struct {
+	ec.ComponentBehavior
+	Speed   float64       `meta:"speed"`
+	Level   int           `meta:"level"`
+	Timeout time.Duration `meta:"timeout"`
+	Title   string        `meta:"title"`
+	awoken  ComponentTestMetaValues
+}
+
+type ComponentTestMetaValues struct {
+	Speed   float64
+	Level   int
+	Timeout time.Duration
+	Title   string
+}
+
+func (c *ComponentTestMeta) Awake() {
+	c.awoken = ComponentTestMetaValues{Speed: c.Speed, Level: c.Level, Timeout: c.Timeout, Title: c.Title}
+}
+
+func Test_CreateEntityBindComponentMeta(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare(
+					pt.NewEntityDescriptor("Meta").SetMeta(map[string]any{"level": 1, "title": "prototype"}),
+					pt.NewComponentDescriptor(ComponentTestMeta{}).SetMeta(map[string]any{"speed": 2, "timeout": "3s"}),
+				)
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(ctx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							entity, err := core.BuildEntity(ctx, "Meta").MergeMeta(map[string]any{"title": "creator"}).New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+							comp := entity.GetComponent("ComponentTestMeta").(*ComponentTestMeta)
+							want := ComponentTestMetaValues{Speed: 2, Level: 1, Timeout: 3 * time.Second, Title: "creator"}
+							if comp.awoken != want {
+								scenario.complete(fmt.Errorf("component meta fields before Awake: got %+v, want %+v", comp.awoken, want))
+								return
+							}
+
+							if _, err := core.BuildEntity(ctx, "Meta").MergeMeta(map[string]any{"level": "high"}).New(); !errors.Is(err, pt.ErrPt) {
+								scenario.complete(fmt.Errorf("create entity with invalid meta: got %v, want pt error", err))
+								return
+							}
+							if got := ctx.EntityManager().CountEntities(); got != 1 {
+								scenario.complete(fmt.Errorf("runtime entity count: got %d, want 1", got))
+								return
+							}
+							scenario.complete(nil)
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+type ComponentTestPool struct {
+	ec.ComponentBehavior
+	buf    []int
+	resets int
+}
+
+func (c *ComponentTestPool) Awake() {
+	c.buf = append(c.buf, 1, 2, 3)
+}
+
+func (c *ComponentTestPool) Reset() {
+	c.buf = c.buf[:0]
+	c.resets++
+}
+
+type ComponentTestPoolPlain struct {
+	ec.ComponentBehavior
+	Value int
+}
+
+func (c *ComponentTestPoolPlain) Awake() {
+	c.Value++
+}
+
+func Test_EntityPool(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("Pooled").SetPoolSize(1), ComponentTestPool{}, ComponentTestPoolPlain{})
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("Unpooled"), ComponentTestPoolPlain{})
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(ctx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							e1, err := core.BuildEntity(ctx, "Pooled").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+							e2, err := core.BuildEntity(ctx, "Pooled").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+							id1 := e1.ID()
+							comp := e1.GetComponent("ComponentTestPool").(*ComponentTestPool)
+							plain := e1.GetComponent("ComponentTestPoolPlain").(*ComponentTestPoolPlain)
+
+							e1.Destroy()
+							e2.Destroy()
+
+							// 回收任务先于该任务入队，执行时实体已放回实体池
+							err = ctx.Post(func(ctx runtime.Context, _ ...any) {
+								stats := ctx.EntityPool().Stats()
+								want := runtime.EntityPoolStats{Prototype: "Pooled", Version: 1, Capacity: 1, Idle: 1, Misses: 2, Recycled: 1, Discarded: 1}
+								if len(stats) != 1 || stats[0] != want {
+									scenario.complete(fmt.Errorf("pool stats after recycle: got %+v, want %+v", stats, want))
+									return
+								}
+								if e1.State() != ec.EntityState_Destroyed || e1.ID() != id1 {
+									scenario.complete(fmt.Errorf("pooled entity: state %q id %q, want Destroyed %q", e1.State(), e1.ID(), id1))
+									return
+								}
+								if comp.resets != 1 || len(comp.buf) != 0 || cap(comp.buf) < 3 {
+									scenario.complete(fmt.Errorf("component reset: resets %d len %d cap %d", comp.resets, len(comp.buf), cap(comp.buf)))
+									return
+								}
+								if plain.Value != 0 {
+									scenario.complete(fmt.Errorf("component without Reset was not zeroed: %d", plain.Value))
+									return
+								}
+
+								// 加入实体管理器失败时，取自实体池的实体放回实体池
+								live, err := core.BuildEntity(ctx, "Unpooled").New()
+								if err != nil {
+									scenario.complete(fmt.Errorf("create unpooled entity: %w", err))
+									return
+								}
+								if dup, err := core.BuildEntity(ctx, "Pooled").SetPersistID(live.ID()).New(); err == nil || dup != nil {
+									scenario.complete(errors.New("duplicate persist id was accepted"))
+									return
+								}
+								if stats := ctx.EntityPool().Stats(); stats[0].Idle != 1 || stats[0].Hits != 1 || stats[0].Recycled != 2 || stats[0].Discarded != 1 {
+									scenario.complete(fmt.Errorf("pool stats after failed add: %+v", stats[0]))
+									return
+								}
+
+								e3, err := core.BuildEntity(ctx, "Pooled").New()
+								if err != nil {
+									scenario.complete(fmt.Errorf("create pooled entity: %w", err))
+									return
+								}
+								if e3 != e1 || e3.ID() == id1 || e3.State() != ec.EntityState_Alive {
+									scenario.complete(fmt.Errorf("reused entity: same %v, id %q, state %q", e3 == e1, e3.ID(), e3.State()))
+									return
+								}
+								if e3.GetComponent("ComponentTestPool") != comp || e3.GetComponent("ComponentTestPoolPlain") != plain {
+									scenario.complete(errors.New("reused entity did not reuse its components"))
+									return
+								}
+								if len(comp.buf) != 3 || plain.Value != 1 || comp.State() != ec.ComponentState_Alive {
+									scenario.complete(fmt.Errorf("reused components: buf %v value %d state %q", comp.buf, plain.Value, comp.State()))
+									return
+								}
+								if _, ok := ctx.EntityManager().GetEntity(id1); ok {
+									scenario.complete(errors.New("stale entity id still resolves"))
+									return
+								}
+								if stats := ctx.EntityPool().Stats(); stats[0].Hits != 2 || stats[0].Idle != 0 {
+									scenario.complete(fmt.Errorf("pool stats after reuse: %+v", stats[0]))
+									return
+								}
+								scenario.complete(nil)
+							})
+							if err != nil {
+								scenario.complete(fmt.Errorf("post: %w", err))
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_EntityPoolStaleReference(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("Pooled").SetPoolSize(1), ComponentTestPoolPlain{})
+			case service.RunningEvent_Started:
+				svcCtx := ctx
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(ctx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							e1, err := core.BuildEntity(ctx, "Pooled").SetScope(ec.Scope_Global).New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+							id1 := e1.ID()
+							stale, ok := svcCtx.EntityManager().GetEntity(id1)
+							if !ok {
+								scenario.complete(errors.New("global entity not registered"))
+								return
+							}
+							plain := e1.GetComponent("ComponentTestPoolPlain").(*ComponentTestPoolPlain)
+
+							e1.Destroy()
+
+							err = ctx.Post(func(ctx runtime.Context, _ ...any) {
+								e2, err := core.BuildEntity(ctx, "Pooled").SetScope(ec.Scope_Global).New()
+								if err != nil {
+									scenario.complete(fmt.Errorf("create pooled entity: %w", err))
+									return
+								}
+								if e2 != e1 || e2.ID() == id1 {
+									scenario.complete(fmt.Errorf("pooled entity was not reused: same %v id %q", e2 == e1, e2.ID()))
+									return
+								}
+								if ec.UnsafeConcurrentEntity(stale).Instance() != e2 {
+									scenario.complete(errors.New("stale reference does not share the reused instance"))
+									return
+								}
+
+								go func() {
+									if stale.ID() != id1 || stale.PT().Prototype() != "Pooled" {
+										scenario.complete(fmt.Errorf("stale reference identity: id %q prototype %q", stale.ID(), stale.PT().Prototype()))
+										return
+									}
+									select {
+									case <-stale.Done():
+									default:
+										scenario.complete(errors.New("stale reference context is not done"))
+										return
+									}
+									if !stale.Terminated().Completed() {
+										scenario.complete(errors.New("stale reference is not terminated"))
+										return
+									}
+
+									ret := core.CallComponent(stale, "ComponentTestPoolPlain", func(c *ComponentTestPoolPlain) int {
+										c.Value = 100
+										return c.Value
+									}).Wait(scenario.ctx)
+									if !errors.Is(ret.Error, core.ErrEntityDead) {
+										scenario.complete(fmt.Errorf("call through stale reference: got %v, want %v", ret.Error, core.ErrEntityDead))
+										return
+									}
+
+									ret = core.CallComponent(e2, "ComponentTestPoolPlain", func(c *ComponentTestPoolPlain) int {
+										return c.Value
+									}).Wait(scenario.ctx)
+									if ret.Error != nil || ret.Value != 1 || plain.Value != 1 {
+										scenario.complete(fmt.Errorf("reused entity was touched by stale reference: %v %v", ret.Value, ret.Error))
+										return
+									}
+									if current, ok := svcCtx.EntityManager().GetEntity(e2.ID()); !ok || current == stale || current.ID() != e2.ID() {
+										scenario.complete(errors.New("service entity-manager did not index the reused entity"))
+										return
+									}
+									scenario.complete(nil)
+								}()
+							})
+							if err != nil {
+								scenario.complete(fmt.Errorf("post: %w", err))
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_ComponentPoolStaleReference(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("Pooled").SetPoolSize(1), ComponentTestPoolPlain{})
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(ctx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							e1, err := core.BuildEntity(ctx, "Pooled").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+							plain := e1.GetComponent("ComponentTestPoolPlain").(*ComponentTestPoolPlain)
+							stale := ec.ConcurrentComponentRef(plain)
+							id1, str1, scope1 := plain.ID(), plain.String(), plain.AsyncScope()
+							if scope1 == nil || str1 == "" {
+								scenario.complete(errors.New("component is not bound to the runtime"))
+								return
+							}
+
+							// 组件回收与复用期间经旧引用持续跨协程读取，-race 下不应报告竞争
+							readerDone := make(chan struct{})
+							readerStop := make(chan struct{})
+							go func() {
+								defer close(readerDone)
+								for {
+									select {
+									case <-readerStop:
+										return
+									default:
+									}
+									_ = stale.AsyncScope()
+									_ = stale.String()
+									_ = stale.Logger()
+									_ = stale.ID()
+								}
+							}()
+
+							e1.Destroy()
+
+							err = ctx.Post(func(ctx runtime.Context, _ ...any) {
+								e2, err := core.BuildEntity(ctx, "Pooled").New()
+								if err != nil {
+									scenario.complete(fmt.Errorf("create pooled entity: %w", err))
+									return
+								}
+								if e2 != e1 || e2.GetComponent("ComponentTestPoolPlain") != plain {
+									scenario.complete(errors.New("pooled component was not reused"))
+									return
+								}
+								close(readerStop)
+
+								go func() {
+									<-readerDone
+
+									if stale.ID() != id1 || stale.String() != str1 || stale.Name() != "ComponentTestPoolPlain" {
+										scenario.complete(fmt.Errorf("stale reference identity: id %q string %q", stale.ID(), stale.String()))
+										return
+									}
+									if ec.UnsafeConcurrentComponent(stale).Instance() != plain {
+										scenario.complete(errors.New("stale reference does not share the reused instance"))
+										return
+									}
+									if stale.AsyncScope() != scope1 || scope1.Err() == nil {
+										scenario.complete(errors.New("stale reference scope is not the closed old scope"))
+										return
+									}
+									if plain.ID() == id1 || plain.String() == str1 {
+										scenario.complete(fmt.Errorf("reused component kept the old identity: %q", plain.ID()))
+										return
+									}
+									if scope2 := plain.AsyncScope(); scope2 == nil || scope2 == scope1 || scope2.Err() != nil {
+										scenario.complete(errors.New("reused component scope is not a fresh open scope"))
+										return
+									}
+									scenario.complete(nil)
+								}()
+							})
+							if err != nil {
+								scenario.complete(fmt.Errorf("post: %w", err))
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+type ComponentTestBatch struct {
+	ec.ComponentBehavior
+	Fail bool `meta:"fail"`
+	log  *[]string
+}
+
+func (c *ComponentTestBatch) Awake() {
+	c.log = c.Entity().PT().Meta().Value("log").(*[]string)
+	*c.log = append(*c.log, "Awake")
+}
+
+func (c *ComponentTestBatch) Start() {
+	*c.log = append(*c.log, "Start")
+	if c.Fail {
+		c.Entity().Destroy()
+	}
+}
+
+func Test_CreateEntityBatch(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	var lifecycle []string
+	counts := map[runtime.RunningEvent]int{}
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare("BatchParent")
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("BatchChild").SetMeta(map[string]any{"log": &lifecycle}), ComponentTestBatch{})
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(ctx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							counts[runningEvent]++
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							parent, err := core.BuildEntity(ctx, "BatchParent").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create parent: %w", err))
+								return
+							}
+							if err := ctx.EntityTree().MakeRoot(parent.ID()); err != nil {
+								scenario.complete(fmt.Errorf("make root: %w", err))
+								return
+							}
+
+							entities, err := core.BuildEntityBatch(ctx).AddPrototype("BatchChild", 3).SetParentID(parent.ID()).New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create batch: %w", err))
+								return
+							}
+							if want := []string{"Awake", "Awake", "Awake", "Start", "Start", "Start"}; !slices.Equal(lifecycle, want) {
+								scenario.complete(fmt.Errorf("batch lifecycle order: got %v, want %v", lifecycle, want))
+								return
+							}
+							if n, _ := ctx.EntityTree().CountChildren(parent.ID()); n != 3 || len(entities) != 3 {
+								scenario.complete(fmt.Errorf("batch children: got %d children and %d entities, want 3", n, len(entities)))
+								return
+							}
+							if counts[runtime.RunningEvent_EntityActivated] != 1 || counts[runtime.RunningEvent_EntitiesActivated] != 1 {
+								scenario.complete(fmt.Errorf("activation events: entity %d, batch %d", counts[runtime.RunningEvent_EntityActivated], counts[runtime.RunningEvent_EntitiesActivated]))
+								return
+							}
+
+							_, err = core.BuildEntityBatch(ctx).
+								AddPrototype("BatchChild", 2).
+								Add(core.BuildEntity(ctx, "BatchChild").MergeMeta(map[string]any{"fail": true}), 1).
+								New()
+							if !errors.Is(err, core.ErrEntityBatchAborted) {
+								scenario.complete(fmt.Errorf("aborted batch: got %v, want ErrEntityBatchAborted", err))
+								return
+							}
+							if n := ctx.EntityManager().CountEntities(); n != 4 {
+								scenario.complete(fmt.Errorf("entities after rollback: got %d, want 4", n))
+								return
+							}
+							if counts[runtime.RunningEvent_EntitiesActivationAborted] != 1 || counts[runtime.RunningEvent_EntityDeactivated] != 3 {
+								scenario.complete(fmt.Errorf("rollback events: aborted %d, deactivated %d", counts[runtime.RunningEvent_EntitiesActivationAborted], counts[runtime.RunningEvent_EntityDeactivated]))
+								return
+							}
+							scenario.complete(nil)
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+type ComponentTestCall struct {
+	ec.ComponentBehavior
+	total int
+}
+
+func (c *ComponentTestCall) Add(n int) int {
+	c.total += n
+	return c.total
+}
+
+func Test_CreateEntityBatchReleasesPooled(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("PooledMeta").SetPoolSize(2), ComponentTestMeta{})
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(ctx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							e1, err := core.BuildEntity(ctx, "PooledMeta").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+							e1.Destroy()
+
+							err = ctx.Post(func(ctx runtime.Context, _ ...any) {
+								_, err := core.BuildEntityBatch(ctx).
+									AddPrototype("PooledMeta", 1).
+									Add(core.BuildEntity(ctx, "PooledMeta").MergeMeta(map[string]any{"level": "high"}), 1).
+									New()
+								if !errors.Is(err, pt.ErrPt) {
+									scenario.complete(fmt.Errorf("create batch with invalid meta: got %v, want pt error", err))
+									return
+								}
+								if stats := ctx.EntityPool().Stats(); len(stats) != 1 || stats[0].Hits != 1 || stats[0].Idle != 1 || stats[0].Recycled != 2 {
+									scenario.complete(fmt.Errorf("pool stats after failed batch: %+v", stats))
+									return
+								}
+								if got := ctx.EntityManager().CountEntities(); got != 0 {
+									scenario.complete(fmt.Errorf("runtime entity count: got %d, want 0", got))
+									return
+								}
+
+								// 批量登记失败时同样回滚整批实体
+								dupID := uid.New()
+								_, err = core.BuildEntityBatch(ctx).
+									Add(core.BuildEntity(ctx, "PooledMeta").SetPersistID(uid.New()), 1).
+									Add(core.BuildEntity(ctx, "PooledMeta").SetPersistID(dupID), 1).
+									Add(core.BuildEntity(ctx, "PooledMeta").SetPersistID(dupID), 1).
+									New()
+								if !errors.Is(err, runtime.ErrEntityManager) {
+									scenario.complete(fmt.Errorf("create batch with duplicate ids: got %v, want entity-manager error", err))
+									return
+								}
+								if stats := ctx.EntityPool().Stats(); len(stats) != 1 || stats[0].Hits != 2 || stats[0].Idle != 1 || stats[0].Recycled != 3 {
+									scenario.complete(fmt.Errorf("pool stats after failed batch add: %+v", stats))
+									return
+								}
+								if got := ctx.EntityManager().CountEntities(); got != 0 {
+									scenario.complete(fmt.Errorf("runtime entity count after failed batch add: got %d, want 0", got))
+									return
+								}
+
+								e2, err := core.BuildEntity(ctx, "PooledMeta").New()
+								if err != nil {
+									scenario.complete(fmt.Errorf("create pooled entity: %w", err))
+									return
+								}
+								if e2 != e1 || e2.State() != ec.EntityState_Alive {
+									scenario.complete(fmt.Errorf("released entity was not reused: same %v state %q", e2 == e1, e2.State()))
+									return
+								}
+								if comp := e2.GetComponent("ComponentTestMeta").(*ComponentTestMeta); comp.Level != 0 {
+									scenario.complete(fmt.Errorf("released component kept bound meta: level %d", comp.Level))
+									return
+								}
+								scenario.complete(nil)
+							})
+							if err != nil {
+								scenario.complete(fmt.Errorf("post: %w", err))
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_CallComponent(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				core.BuildEntityPT(ctx, "Callee").
+					AddComponent(ComponentTestCall{}, "Counter").
+					AddComponent(ComponentTest1{}, "Plain").
+					AddComponent(pt.NewComponentDescriptor(ComponentTest1{}).SetName("Extra").SetRemovable(true)).
+					Declare()
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							entity, err := core.BuildEntity(rtCtx, "Callee").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+
+							go func() {
+								scenario.complete(func() error {
+									add := func(n int) func(*ComponentTestCall) int {
+										return func(c *ComponentTestCall) int { return c.Add(n) }
+									}
+
+									ret := core.CallComponent(entity, "Counter", add(2)).Wait(scenario.ctx)
+									if ret.Error != nil || ret.Value != 2 {
+										return fmt.Errorf("call component: got %v, %v, want 2", ret.Value, ret.Error)
+									}
+									if ret := core.CallComponentVoid(entity, "Counter", func(c *ComponentTestCall) { c.Add(3) }).Wait(scenario.ctx); ret.Error != nil {
+										return fmt.Errorf("call component void: %w", ret.Error)
+									}
+									if err := core.PostComponent(entity, "Counter", func(c *ComponentTestCall) { c.Add(4) }); err != nil {
+										return fmt.Errorf("post component: %w", err)
+									}
+									if ret := core.CallComponent(entity, "Counter", add(0)).Wait(scenario.ctx); ret.Value != 9 {
+										return fmt.Errorf("component total after void calls: got %v, %v, want 9", ret.Value, ret.Error)
+									}
+
+									for _, c := range []struct {
+										ret  async.Result
+										want error
+									}{
+										{ret: core.CallComponent(entity, "Missing", add(1)).Wait(scenario.ctx), want: core.ErrComponentNotFound},
+										{ret: core.CallComponent(entity, "Plain", add(1)).Wait(scenario.ctx), want: core.ErrComponentTypeMismatch},
+										{ret: core.CallComponentVoid(entity, "Extra", func(c ec.Component) { c.Destroy() }).Wait(scenario.ctx)},
+										{ret: core.CallComponentVoid(entity, "Extra", func(ec.Component) {}).Wait(scenario.ctx), want: core.ErrComponentRemoved},
+									} {
+										if !errors.Is(c.ret.Error, c.want) {
+											return fmt.Errorf("failed component call: got %v, want %v", c.ret.Error, c.want)
+										}
+									}
+
+									core.CallComponentVoid(entity, "Counter", func(c *ComponentTestCall) { c.Entity().Destroy() })
+									if ret := core.CallComponent(entity, "Counter", add(1)).Wait(scenario.ctx); !errors.Is(ret.Error, core.ErrEntityDead) {
+										return fmt.Errorf("call queued behind destroy: got %v, want %v", ret.Error, core.ErrEntityDead)
+									}
+									<-entity.Done()
+									if err := core.PostComponent(entity, "Counter", func(*ComponentTestCall) {}); !errors.Is(err, core.ErrEntityDead) {
+										return fmt.Errorf("post to dead entity: got %v, want %v", err, core.ErrEntityDead)
+									}
+									return nil
+								}())
+							}()
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+type MsgTestDeposit struct{ Amount int }
+
+type MsgTestBalance struct{}
+
+type MsgTestReset struct{}
+
+type MsgTestBoom struct{}
+
+type MsgTestUnknown struct{}
+
+type MsgTestUndeclared struct{}
+
+type ComponentTestAccount struct {
+	ec.ComponentBehavior
+	balance int
+}
+
+func (c *ComponentTestAccount) MessageHandlerMethods() []string {
+	return []string{"HandleDeposit", "HandleBalance", "HandleReset", "HandleBoom"}
+}
+
+func (c *ComponentTestAccount) HandleDeposit(msg MsgTestDeposit) (int, error) {
+	if msg.Amount <= 0 {
+		return 0, fmt.Errorf("invalid amount %d", msg.Amount)
+	}
+	c.balance += msg.Amount
+	return c.balance, nil
+}
+
+func (c *ComponentTestAccount) HandleBalance(MsgTestBalance) int {
+	return c.balance
+}
+
+func (c *ComponentTestAccount) HandleReset(MsgTestReset) {
+	c.balance = 0
+}
+
+func (c *ComponentTestAccount) HandleBoom(MsgTestBoom) {
+	panic("boom")
+}
+
+// HandleUndeclared 未在 MessageHandlerMethods 中声明，不会登记为消息处理器
+func (c *ComponentTestAccount) HandleUndeclared(MsgTestUndeclared) {}
+
+type ComponentTestBadHandler struct {
+	ec.ComponentBehavior
+}
+
+func (c *ComponentTestBadHandler) MessageHandlerMethods() []string {
+	return []string{"HandleMissing"}
+}
+
+func Test_AskTell(t *testing.T) {
+	func() {
+		defer func() {
+			if panicInfo := recover(); panicInfo == nil {
+				t.Fatal("declaring two components handling the same message did not panic")
+			}
+		}()
+		pt.NewEntityLib(pt.NewComponentLib()).Declare("Conflict",
+			pt.NewComponentDescriptor(ComponentTestAccount{}).SetName("A"),
+			pt.NewComponentDescriptor(ComponentTestAccount{}).SetName("B"),
+		)
+	}()
+	func() {
+		defer func() {
+			if panicInfo := recover(); panicInfo == nil {
+				t.Fatal("declaring a component with a missing message handler did not panic")
+			}
+		}()
+		pt.NewComponentLib().Declare(ComponentTestBadHandler{})
+	}()
+
+	scenario := newCoreTestScenario(3 * time.Second)
+	reportError := make(chan error, 1)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				core.BuildEntityPT(ctx, "Account").AddComponent(ComponentTestAccount{}).Declare()
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.PanicHandling(false, reportError),
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							entity, err := core.BuildEntity(rtCtx, "Account").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+
+							go func() {
+								scenario.complete(func() error {
+									if ret := core.Ask[MsgTestDeposit, int](entity, MsgTestDeposit{Amount: 5}).Wait(scenario.ctx); ret.Error != nil || ret.Value != 5 {
+										return fmt.Errorf("ask deposit: got %v, %v, want 5", ret.Value, ret.Error)
+									}
+									if err := core.Tell(entity, MsgTestDeposit{Amount: 3}); err != nil {
+										return fmt.Errorf("tell deposit: %w", err)
+									}
+									if ret := core.Ask[MsgTestBalance, int](entity, MsgTestBalance{}).Wait(scenario.ctx); ret.Error != nil || ret.Value != 8 {
+										return fmt.Errorf("ask balance: got %v, %v, want 8", ret.Value, ret.Error)
+									}
+									if ret := core.Ask[MsgTestDeposit, int](entity, MsgTestDeposit{Amount: -1}).Wait(scenario.ctx); ret.Error == nil {
+										return errors.New("ask invalid deposit: handler error was not returned")
+									}
+									if ret := core.Ask[MsgTestReset, int](entity, MsgTestReset{}).Wait(scenario.ctx); ret.Error != nil || ret.Value != 0 {
+										return fmt.Errorf("ask reset: got %v, %v, want zero response", ret.Value, ret.Error)
+									}
+									if ret := core.Ask[MsgTestBalance, string](entity, MsgTestBalance{}).Wait(scenario.ctx); !errors.Is(ret.Error, core.ErrMessageTypeMismatch) {
+										return fmt.Errorf("ask with wrong response type: got %v, want %v", ret.Error, core.ErrMessageTypeMismatch)
+									}
+									if err := core.Tell(entity, MsgTestUnknown{}); !errors.Is(err, core.ErrUnknownMessage) {
+										return fmt.Errorf("tell unknown message: got %v, want %v", err, core.ErrUnknownMessage)
+									}
+									if err := core.Tell(entity, MsgTestUndeclared{}); !errors.Is(err, core.ErrUnknownMessage) {
+										return fmt.Errorf("tell undeclared handler: got %v, want %v", err, core.ErrUnknownMessage)
+									}
+									if ret := core.Ask[MsgTestBoom, struct{}](entity, MsgTestBoom{}).Wait(scenario.ctx); !errors.Is(ret.Error, core.ErrPanicked) {
+										return fmt.Errorf("ask panicking handler: got %v, want %v", ret.Error, core.ErrPanicked)
+									}
+									select {
+									case err := <-reportError:
+										if !errors.Is(err, core.ErrPanicked) {
+											return fmt.Errorf("reported handler panic: got %v", err)
+										}
+									default:
+										return errors.New("handler panic was not reported")
+									}
+
+									stats := runtime.Concurrent(entity).MessageStats().Stats()
+									i := slices.IndexFunc(stats, func(s runtime.MessageStats) bool { return s.Type == "core_test.MsgTestDeposit" })
+									if i < 0 {
+										return fmt.Errorf("deposit message stats missing: %v", stats)
+									}
+									if got, want := stats[i], (runtime.MessageStats{Type: "core_test.MsgTestDeposit", Asked: 2, Told: 1, Handled: 2, Failed: 1}); got != want {
+										return fmt.Errorf("deposit message stats: got %+v, want %+v", got, want)
+									}
+									return nil
+								}())
+							}()
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_StreamCombinators(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	source := func(values ...any) async.Stream {
+		emitter, stream := async.NewStream(len(values))
+		for _, v := range values {
+			emitter.Emit(ctx, async.NewResult(v, nil))
+		}
+		emitter.Close()
+		return stream
+	}
+	collect := func(stream async.Stream) []any {
+		ret := async.Collect(ctx, stream).Wait(ctx)
+		if !ret.OK() {
+			t.Fatalf("collect failed: %v", ret.Error)
+		}
+		return ret.Value.([]any)
+	}
+
+	doubled := async.MapStream(ctx, source(1, 2, 3), func(ret async.Result) async.Result {
+		return async.NewResult(ret.Value.(int)*2, nil)
+	})
+	if got := collect(doubled); !slices.Equal(got, []any{2, 4, 6}) {
+		t.Fatalf("map = %v", got)
+	}
+
+	odd := async.FilterStream(ctx, source(1, 2, 3, 4, 5), func(ret async.Result) bool {
+		return ret.Value.(int)%2 == 1
+	})
+	if got := collect(async.Take(ctx, odd, 2)); !slices.Equal(got, []any{1, 3}) {
+		t.Fatalf("filter/take = %v", got)
+	}
+
+	panicked := async.MapStream(ctx, source(1), func(async.Result) async.Result { panic("boom") })
+	if ret := async.Collect(ctx, panicked).Wait(ctx); !errors.Is(ret.Error, core.ErrPanicked) {
+		t.Fatalf("map panic = %v", ret.Error)
+	}
+
+	merged := collect(async.Merge(ctx, source(1, 2), source(3), source()))
+	slices.SortFunc(merged, func(a, b any) int { return a.(int) - b.(int) })
+	if !slices.Equal(merged, []any{1, 2, 3}) {
+		t.Fatalf("merge = %v", merged)
+	}
+
+	batches := collect(async.Batch(ctx, source(1, 2, 3, 4, 5), 2, time.Second))
+	if len(batches) != 3 || !slices.Equal(batches[2].([]any), []any{5}) {
+		t.Fatalf("batch = %v", batches)
+	}
+
+	emitter, stream := async.NewStream()
+	timed := async.Batch(ctx, stream, 10, 20*time.Millisecond)
+	emitter.Emit(ctx, async.NewResult(1, nil))
+	if ret, ok := timed.Next(ctx); !ok || !slices.Equal(ret.Value.([]any), []any{1}) {
+		t.Fatalf("batch timeout = %v, %v", ret, ok)
+	}
+	emitter.Close()
+
+	throttled := collect(async.Throttle(ctx, source(1, 2, 3), time.Hour))
+	if !slices.Equal(throttled, []any{1}) {
+		t.Fatalf("throttle = %v", throttled)
+	}
+
+	emitter, stream = async.NewStream()
+	debounced := async.Debounce(ctx, stream, 20*time.Millisecond)
+	for i := range 3 {
+		emitter.Emit(ctx, async.NewResult(i, nil))
+	}
+	if ret, ok := debounced.Next(ctx); !ok || ret.Value != 2 {
+		t.Fatalf("debounce = %v, %v", ret, ok)
+	}
+	emitter.Close()
+
+	failing := errors.New("stream failed")
+	emitter, stream = async.NewStream(2)
+	emitter.Emit(ctx, async.NewResult(1, nil))
+	emitter.Emit(ctx, async.NewResult(nil, failing))
+	if ret := async.Collect(ctx, stream).Wait(ctx); !errors.Is(ret.Error, failing) {
+		t.Fatalf("collect error = %v", ret.Error)
+	}
+
+	cancelCtx, cancelCollect := context.WithCancel(ctx)
+	_, idle := async.NewStream()
+	future := async.Collect(cancelCtx, idle)
+	cancelCollect()
+	if ret := future.Wait(ctx); !errors.Is(ret.Error, context.Canceled) {
+		t.Fatalf("collect cancel = %v", ret.Error)
+	}
+}
+
+func Test_TypedFuture(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			if runningEvent != service.RunningEvent_Started {
+				return
+			}
+			core.NewRuntime(
+				runtime.NewContext(ctx,
+					runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+						if runningEvent != runtime.RunningEvent_Started {
+							return
+						}
+
+						selfWait := core.SubmitTyped(rtCtx, func(runtime.Context) (int, error) { return 1, nil })
+						if _, err := selfWait.Wait(rtCtx); !errors.Is(err, runtime.ErrRuntimeSelfWait) {
+							scenario.complete(fmt.Errorf("typed self wait: got %v, want %v", err, runtime.ErrRuntimeSelfWait))
+							return
+						}
+
+						go func() {
+							scenario.complete(func() error {
+								answer := core.SubmitTyped(rtCtx, func(runtime.Context) (int, error) { return 42, nil })
+								if v, err := answer.Wait(scenario.ctx); err != nil || v != 42 {
+									return fmt.Errorf("submit typed: got %v, %v, want 42", v, err)
+								}
+
+								text := core.ContinueOnTyped(rtCtx, answer, func(_ runtime.Context, v int, err error) (string, error) {
+									return fmt.Sprintf("answer=%d", v), err
+								})
+								if v, err := text.Wait(scenario.ctx); err != nil || v != "answer=42" {
+									return fmt.Errorf("continue on typed: got %q, %v", v, err)
+								}
+
+								failing := errors.New("typed failure")
+								if _, err := core.SubmitTyped(rtCtx, func(runtime.Context) (int, error) { return 0, failing }).Wait(scenario.ctx); !errors.Is(err, failing) {
+									return fmt.Errorf("submit typed error: got %v, want %v", err, failing)
+								}
+
+								promise, pending := async.NewTypedPromise[int]()
+								all := async.TypedAll(async.TypedResolved(1), pending, async.TypedResolved(3))
+								race := async.TypedRace(pending, async.TypedRejected[int](failing))
+								if _, err := race.Wait(scenario.ctx); !errors.Is(err, failing) {
+									return fmt.Errorf("typed race: got %v, want %v", err, failing)
+								}
+								promise.Resolve(2)
+								if v, err := all.Wait(scenario.ctx); err != nil || !slices.Equal(v, []int{1, 2, 3}) {
+									return fmt.Errorf("typed all: got %v, %v", v, err)
+								}
+
+								doubled := async.TypedMap(pending, func(v int) (int, error) { return v * 2, nil })
+								if v, err, ok := doubled.TryGet(); !ok || err != nil || v != 4 {
+									return fmt.Errorf("typed map: got %v, %v, %v", v, err, ok)
+								}
+
+								mismatch := async.AsTyped[string](async.Resolved(async.NewResult(1, nil)))
+								if _, err := mismatch.Wait(scenario.ctx); !errors.Is(err, async.ErrResultTypeMismatch) {
+									return fmt.Errorf("typed mismatch: got %v, want %v", err, async.ErrResultTypeMismatch)
+								}
+								return nil
+							}())
+						}()
+					}),
+				),
+				core.With.Runtime.AutoRun(true),
+				core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+			)
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_Retry(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	flaky := errors.New("flaky")
+	fatal := errors.New("fatal")
+
+	scope := async.NewScope(ctx)
+	var calls int
+	ret := async.Retry(scope, async.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, Multiplier: 2, Jitter: 0.5},
+		func(context.Context) async.Result {
+			calls++
+			if calls < 3 {
+				return async.NewResult(nil, flaky)
+			}
+			return async.NewResult(calls, nil)
+		}).Wait(ctx)
+	if ret.Error != nil || ret.Value != 3 {
+		t.Fatalf("retry: got %v, %v, want 3", ret.Value, ret.Error)
+	}
+	if stats := scope.Stats(); stats.Spawned != 1 || stats.Attempts != 3 || stats.Retried != 2 || stats.Exhausted != 0 {
+		t.Fatalf("retry stats: %+v", stats)
+	}
+
+	ret = async.Retry(scope, async.RetryPolicy{MaxAttempts: 2}, func(context.Context) async.Result {
+		return async.NewResult(nil, flaky)
+	}).Wait(ctx)
+	if !errors.Is(ret.Error, async.ErrRetryExhausted) || !errors.Is(ret.Error, flaky) {
+		t.Fatalf("retry exhausted: got %v", ret.Error)
+	}
+
+	calls = 0
+	ret = async.Retry(scope, async.RetryPolicy{Retryable: func(err error) bool { return !errors.Is(err, fatal) }},
+		func(context.Context) async.Result {
+			calls++
+			return async.NewResult(nil, fatal)
+		}).Wait(ctx)
+	if !errors.Is(ret.Error, fatal) || calls != 1 {
+		t.Fatalf("retry non-retryable: got %v after %d calls", ret.Error, calls)
+	}
+
+	ret = async.Retry(scope, async.RetryPolicy{}, func(context.Context) async.Result { panic("boom") }).Wait(ctx)
+	if !errors.Is(ret.Error, core.ErrPanicked) {
+		t.Fatalf("retry panic: got %v", ret.Error)
+	}
+
+	if stats := scope.Stats(); stats.Attempts != 7 || stats.Retried != 3 || stats.Exhausted != 1 {
+		t.Fatalf("retry stats: %+v", stats)
+	}
+
+	policy := async.RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond, Multiplier: 2}
+	for attempt, want := range []time.Duration{0, 10, 20, 40, 40} {
+		if got := policy.Backoff(attempt); got != want*time.Millisecond {
+			t.Fatalf("backoff(%d) = %v, want %v", attempt, got, want*time.Millisecond)
+		}
+	}
+
+	started := make(chan struct{}, 1)
+	pending := async.Retry(scope, async.RetryPolicy{InitialBackoff: time.Hour}, func(context.Context) async.Result {
+		started <- struct{}{}
+		return async.NewResult(nil, flaky)
+	})
+	<-started
+	closing := errors.New("owner closed")
+	scope.Close(closing)
+	if ret := pending.Wait(ctx); !errors.Is(ret.Error, closing) || !errors.Is(ret.Error, flaky) {
+		t.Fatalf("retry scope close: got %v", ret.Error)
+	}
+	if ret := async.Retry(scope, async.RetryPolicy{}, func(context.Context) async.Result { return async.NewResult(nil, nil) }).Wait(ctx); !errors.Is(ret.Error, async.ErrScopeClosed) {
+		t.Fatalf("retry closed scope: got %v", ret.Error)
+	}
+}
+
+func Test_ScopeLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	scope := async.NewScope(ctx)
+	scope.SetLimit(2, async.ScopeLimitPolicy_Queue)
+
+	release := make(chan struct{})
+	var running, peak atomic.Int64
+	task := func(context.Context) async.Result {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		<-release
+		running.Add(-1)
+		return async.NewResult(nil, nil)
+	}
+
+	futures := make([]async.Future, 5)
+	for i := range futures {
+		futures[i] = async.Spawn(scope, task)
+	}
+	if stats := scope.Stats(); stats.Active != 5 || stats.Queued != 3 || stats.Limit != 2 {
+		t.Fatalf("queued stats: %+v", stats)
+	}
+	close(release)
+	if ret := async.All(futures...).Wait(ctx); ret.Error != nil {
+		t.Fatalf("queued tasks: %v", ret.Error)
+	}
+	if peak.Load() > 2 {
+		t.Fatalf("peak running = %d, want <= 2", peak.Load())
+	}
+
+	block := make(chan struct{})
+	scope.SetLimit(1, async.ScopeLimitPolicy_Reject)
+	running1 := async.Spawn(scope, func(context.Context) async.Result { <-block; return async.NewResult(nil, nil) })
+	if ret := async.Spawn(scope, task).Wait(ctx); !errors.Is(ret.Error, async.ErrScopeLimitExceeded) {
+		t.Fatalf("reject policy: got %v", ret.Error)
+	}
+
+	scope.SetLimit(1, async.ScopeLimitPolicy_Queue)
+	queued := async.Spawn(scope, task)
+	closing := errors.New("owner closed")
+	scope.Close(closing)
+	if ret := queued.Wait(ctx); !errors.Is(ret.Error, closing) {
+		t.Fatalf("queued on close: got %v", ret.Error)
+	}
+	close(block)
+	running1.Wait(ctx)
+	scope.Completion().Wait(ctx)
+	if stats := scope.Stats(); stats.Active != 0 || stats.Queued != 0 || stats.Rejected != 1 || stats.Spawned != 7 {
+		t.Fatalf("closed stats: %+v", stats)
+	}
+
+	sem := async.NewSemaphore(3)
+	if !sem.TryAcquire(2) || sem.TryAcquire(2) {
+		t.Fatal("semaphore try acquire")
+	}
+	semScope := async.NewScope(ctx)
+	weighted := async.SpawnWeighted(semScope, sem, 2, func(context.Context) async.Result { return async.NewResult(1, nil) })
+	for sem.Waiting() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	sem.Release(2)
+	if ret := weighted.Wait(ctx); ret.Error != nil || ret.Value != 1 {
+		t.Fatalf("weighted spawn: got %v, %v", ret.Value, ret.Error)
+	}
+	if sem.Used() != 0 {
+		t.Fatalf("semaphore used = %d, want 0", sem.Used())
+	}
+
+	sem.TryAcquire(3)
+	waiting := async.SpawnWeighted(semScope, sem, 1, func(context.Context) async.Result { return async.NewResult(nil, nil) })
+	for sem.Waiting() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	semScope.Close(closing)
+	if ret := waiting.Wait(ctx); !errors.Is(ret.Error, closing) {
+		t.Fatalf("weighted spawn on close: got %v", ret.Error)
+	}
+	if sem.Waiting() != 0 || sem.Used() != 3 {
+		t.Fatalf("semaphore after cancel: waiting %d, used %d", sem.Waiting(), sem.Used())
+	}
+}
+
+func Test_RetryReleasesScopeLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	scope := async.NewScope(ctx)
+	scope.SetLimit(1, async.ScopeLimitPolicy_Reject)
+
+	flaky := errors.New("flaky")
+	var attempts atomic.Int64
+	failed := make(chan struct{})
+	retry := async.Retry(scope, async.RetryPolicy{MaxAttempts: 2, InitialBackoff: 50 * time.Millisecond}, func(context.Context) async.Result {
+		if attempts.Add(1) == 1 {
+			close(failed)
+			return async.NewResult(nil, flaky)
+		}
+		return async.NewResult(nil, nil)
+	})
+	<-failed
+	for scope.Stats().Running != 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	if ret := async.Spawn(scope, func(context.Context) async.Result { return async.NewResult(attempts.Load(), nil) }).Wait(ctx); ret.Error != nil || ret.Value != int64(1) {
+		t.Fatalf("spawn during retry backoff: got %v, %v, want to run before the second attempt", ret.Value, ret.Error)
+	}
+	if ret := retry.Wait(ctx); ret.Error != nil || attempts.Load() != 2 {
+		t.Fatalf("retry after backoff: got %v after %d attempts", ret.Error, attempts.Load())
+	}
+
+	scope.SetLimit(1, async.ScopeLimitPolicy_Queue)
+	failed = make(chan struct{})
+	pending := async.Retry(scope, async.RetryPolicy{InitialBackoff: 10 * time.Millisecond}, func(context.Context) async.Result {
+		select {
+		case <-failed:
+		default:
+			close(failed)
+		}
+		return async.NewResult(nil, flaky)
+	})
+	<-failed
+	block := make(chan struct{})
+	blocking := async.Spawn(scope, func(context.Context) async.Result { <-block; return async.NewResult(nil, nil) })
+	for scope.Stats().Queued != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	closing := errors.New("owner closed")
+	scope.Close(closing)
+	if ret := pending.Wait(ctx); !errors.Is(ret.Error, closing) || !errors.Is(ret.Error, flaky) {
+		t.Fatalf("retry waiting for a slot on close: got %v", ret.Error)
+	}
+	close(block)
+	blocking.Wait(ctx)
+	scope.Completion().Wait(ctx)
+	if stats := scope.Stats(); stats.Active != 0 || stats.Running != 0 || stats.Queued != 0 {
+		t.Fatalf("closed stats: %+v", stats)
+	}
+}
+
+type ComponentTestTimer struct {
+	ec.ComponentBehavior
+	ticks int
+	fired int
+	every core.Timer
+}
+
+func (c *ComponentTestTimer) Start() {
+	c.every = core.EveryFunc(c, 2*time.Millisecond, func() { c.ticks++ })
+}
+
+func Test_RuntimeTimers(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				core.BuildEntityPT(ctx, "Timed").AddComponent(ComponentTestTimer{}, "Timer").Declare()
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							entity, err := core.BuildEntity(rtCtx, "Timed").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create timed entity: %w", err))
+								return
+							}
+							comp := entity.GetComponent("Timer").(*ComponentTestTimer)
+
+							var order []int
+							core.AfterFunc(rtCtx, 6*time.Millisecond, func() { order = append(order, 2) })
+							core.AfterFunc(rtCtx, 3*time.Millisecond, func() { order = append(order, 1) })
+							core.AfterFunc(comp, time.Millisecond, func() { comp.fired++ })
+							stopped := core.AfterFunc(entity, time.Millisecond, func() { order = append(order, -1) })
+							stopped.Stop()
+
+							read := func(fn func() any) any {
+								return core.Submit(rtCtx, func(runtime.Context, ...any) async.Result {
+									return async.NewResult(fn(), nil)
+								}).Wait(scenario.ctx).Value
+							}
+							sleepUntil := func(cond func() bool) bool {
+								for range 200 {
+									if read(func() any { return cond() }).(bool) {
+										return true
+									}
+									time.Sleep(time.Millisecond)
+								}
+								return false
+							}
+
+							go func() {
+								scenario.complete(func() error {
+									if !sleepUntil(func() bool { return len(order) == 2 && comp.ticks >= 3 && comp.fired == 1 }) {
+										return fmt.Errorf("timers did not fire: order %v, ticks %d, fired %d", order, comp.ticks, comp.fired)
+									}
+									if !slices.Equal(read(func() any { return slices.Clone(order) }).([]int), []int{1, 2}) {
+										return fmt.Errorf("timer order: %v", order)
+									}
+
+									paused := read(func() any {
+										comp.SetEnabled(false)
+										return comp.every.Paused()
+									}).(bool)
+									if !paused {
+										return errors.New("component timer not paused after disable")
+									}
+									ticks := read(func() any { return comp.ticks }).(int)
+									time.Sleep(10 * time.Millisecond)
+									if got := read(func() any { return comp.ticks }).(int); got != ticks {
+										return fmt.Errorf("paused timer ticked: %d -> %d", ticks, got)
+									}
+
+									read(func() any { comp.SetEnabled(true); return nil })
+									if !sleepUntil(func() bool { return comp.ticks > ticks }) {
+										return errors.New("component timer did not resume after enable")
+									}
+
+									read(func() any { entity.Destroy(); return nil })
+									if !read(func() any { return comp.every.Stopped() }).(bool) {
+										return errors.New("component timer not stopped after entity destroy")
+									}
+									return nil
+								}())
+							}()
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_RuntimeFrameTimers(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+	var firedAt []int64
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			if runningEvent != service.RunningEvent_Started {
+				return
+			}
+			core.NewRuntime(
+				runtime.NewContext(ctx,
+					runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+						switch runningEvent {
+						case runtime.RunningEvent_Started:
+							for _, frames := range []int64{2, 0, 4} {
+								core.AfterFrames(rtCtx, frames, func() {
+									firedAt = append(firedAt, rtCtx.Frame().CurFrames())
+								})
+							}
+							core.AfterFrames(rtCtx, 100, func() { firedAt = append(firedAt, -1) })
+						case runtime.RunningEvent_Terminated:
+							scenario.complete(nil)
+						}
+					}),
+				),
+				core.With.Runtime.AutoRun(true),
+				core.With.Runtime.Frame(
+					core.With.Frame.TargetFPS(200),
+					core.With.Frame.TotalFrames(6),
+				),
+			)
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+	if !slices.Equal(firedAt, []int64{0, 2, 4}) {
+		t.Fatalf("frame timers fired at %v, want [0 2 4]", firedAt)
+	}
+}
+
+func Test_RuntimeTimingWheel(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+	var (
+		order       []int
+		executorOK  bool
+		pending     async.Future
+		ticks       atomic.Int64
+		everyClosed = make(chan struct{})
+	)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			if runningEvent != service.RunningEvent_Started {
+				return
+			}
+			core.NewRuntime(
+				runtime.NewContext(ctx,
+					runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+						if runningEvent != runtime.RunningEvent_Started {
+							return
+						}
+
+						later := core.After(rtCtx, 8*time.Millisecond)
+						later.OnComplete(func(async.Result) { order = append(order, 2) })
+						core.At(rtCtx, time.Now().Add(2*time.Millisecond)).OnComplete(func(async.Result) { order = append(order, 1) })
+						executorOK = later.CompletionExecutorID() == rtCtx.ExecutorID()
+						pending = core.After(rtCtx, time.Hour)
+
+						stream := core.Every(rtCtx, time.Millisecond)
+						go func() {
+							defer close(everyClosed)
+							for range stream.Chan() {
+								ticks.Add(1)
+							}
+						}()
+
+						go func() {
+							for range 200 {
+								done := core.Submit(rtCtx, func(runtime.Context, ...any) async.Result {
+									return async.NewResult(len(order) == 2, nil)
+								}).Wait(scenario.ctx).Value
+								if done == true && ticks.Load() >= 3 {
+									scenario.complete(nil)
+									return
+								}
+								time.Sleep(time.Millisecond)
+							}
+							scenario.complete(errors.New("timing wheel timers did not fire"))
+						}()
+					}),
+				),
+				core.With.Runtime.AutoRun(true),
+				core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+			)
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+	if !slices.Equal(order, []int{1, 2}) {
+		t.Fatalf("timing wheel order: %v", order)
+	}
+	if !executorOK {
+		t.Fatal("runtime After future does not complete on the runtime executor")
+	}
+	if ret, ok := pending.TryGet(); !ok || ret.Error == nil {
+		t.Fatalf("pending After not canceled on runtime termination: %v, %v", ret, ok)
+	}
+	select {
+	case <-everyClosed:
+	case <-time.After(time.Second):
+		t.Fatal("runtime Every stream not closed on runtime termination")
+	}
+}
+
+func Test_RuntimeTimingWheelWake(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			if runningEvent != service.RunningEvent_Started {
+				return
+			}
+			core.NewRuntime(
+				runtime.NewContext(ctx,
+					runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+						if runningEvent != runtime.RunningEvent_Started {
+							return
+						}
+
+						core.After(rtCtx, time.Hour)
+						go func() {
+							time.Sleep(5 * time.Millisecond)
+							for _, dur := range []time.Duration{80 * time.Millisecond, 3 * time.Millisecond} {
+								start := time.Now()
+								if ret := core.After(rtCtx, dur).Wait(scenario.ctx); ret.Error != nil {
+									scenario.complete(ret.Error)
+									return
+								}
+								if elapsed := time.Since(start); elapsed < dur || elapsed > dur+500*time.Millisecond {
+									scenario.complete(fmt.Errorf("After(%v) inserted from another goroutine fired after %v", dur, elapsed))
+									return
+								}
+							}
+							scenario.complete(nil)
+						}()
+					}),
+				),
+				core.With.Runtime.AutoRun(true),
+				core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+			)
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_RuntimeTaskBatch(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+	var (
+		executed []int
+		begins   int
+		batches  core.RuntimeBatchStats
+		calls    int
+	)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			if runningEvent != service.RunningEvent_Started {
+				return
+			}
+			core.NewRuntime(
+				runtime.NewContext(ctx,
+					runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+						switch runningEvent {
+						case runtime.RunningEvent_RunCallBegin:
+							begins++
+						case runtime.RunningEvent_Started:
+							begins = 0
+							for i := range 40 {
+								core.Post(rtCtx, func(runtime.Context, ...any) { executed = append(executed, i) })
+							}
+							core.Post(rtCtx, func(rtCtx runtime.Context, _ ...any) {
+								calls = begins
+								batches = runtime.UnsafeContext(rtCtx).Caller().(core.Runtime).Stats().Batches
+								scenario.complete(nil)
+							})
+						}
+					}),
+				),
+				core.With.Runtime.AutoRun(true),
+				core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				core.With.Runtime.TaskQueue(
+					core.With.TaskQueue.Unbounded(false),
+					core.With.TaskQueue.BatchSize(16),
+					core.With.TaskQueue.BatchEvents(true),
+				),
+			)
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+	if len(executed) != 40 || !slices.IsSorted(executed) {
+		t.Fatalf("batched tasks executed out of order: %v", executed)
+	}
+	if calls != 3 {
+		t.Fatalf("RunCallBegin per batch: got %d, want 3", calls)
+	}
+	if batches.MaxSize != 16 || batches.Sizes[4] < 2 || batches.Tasks < 32 {
+		t.Fatalf("batch stats: %+v", batches)
+	}
+}
+
+// runTaskQueueScenario 以给定设置启动运行时，在独立 goroutine 中执行 fn 并以其结果结束场景。
+func runTaskQueueScenario(t *testing.T, settings []option.Setting[core.RuntimeOptions], fn func(rtCtx runtime.Context) error) {
+	t.Helper()
+	scenario := newCoreTestScenario(5 * time.Second)
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			if runningEvent != service.RunningEvent_Started {
+				return
+			}
+			core.NewRuntime(
+				runtime.NewContext(ctx,
+					runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+						if runningEvent == runtime.RunningEvent_Started {
+							go func() { scenario.complete(fn(rtCtx)) }()
+						}
+					}),
+				),
+				append([]option.Setting[core.RuntimeOptions]{core.With.Runtime.AutoRun(true)}, settings...)...,
+			)
+		}),
+	)
+	scenario.run(t, svcCtx)
+}
+
+func Test_RuntimeRingTaskQueue(t *testing.T) {
+	const (
+		producers = 4
+		perWorker = 2000
+	)
+
+	ring := func(settings ...option.Setting[core.TaskQueueOptions]) []option.Setting[core.RuntimeOptions] {
+		return []option.Setting[core.RuntimeOptions]{
+			core.With.Runtime.Frame(core.With.Frame.TargetFPS(200)),
+			core.With.Runtime.TaskQueue(append([]option.Setting[core.TaskQueueOptions]{core.With.TaskQueue.Backend(core.TaskQueueBackend_Ring)}, settings...)...),
+		}
+	}
+
+	t.Run("unbounded", func(t *testing.T) {
+		runTaskQueueScenario(t, ring(core.With.TaskQueue.Capacity(8), core.With.TaskQueue.BatchSize(32)), func(rtCtx runtime.Context) error {
+			var received [producers][]int
+			var wg sync.WaitGroup
+			for p := range producers {
+				wg.Go(func() {
+					for i := range perWorker {
+						if err := core.Post(rtCtx, func(runtime.Context, ...any) { received[p] = append(received[p], i) }); err != nil {
+							t.Error(err)
+							return
+						}
+					}
+				})
+			}
+			wg.Wait()
+
+			ret := core.Submit(rtCtx, func(runtime.Context, ...any) async.Result {
+				for p := range received {
+					if len(received[p]) != perWorker || !slices.IsSorted(received[p]) {
+						return async.NewResult(nil, fmt.Errorf("producer %d: received %d tasks out of order", p, len(received[p])))
+					}
+				}
+				return async.Result{}
+			}).Wait(context.Background())
+			return ret.Error
+		})
+	})
+
+	t.Run("bounded capacity", func(t *testing.T) {
+		defer func() {
+			if panicInfo := recover(); panicInfo == nil {
+				t.Fatal("bounded ring with a capacity that is not a power of 2 did not panic")
+			}
+		}()
+		core.NewRuntime(runtime.NewContext(service.NewContext()), ring(core.With.TaskQueue.Unbounded(false), core.With.TaskQueue.Capacity(6))...)
+	})
+
+	t.Run("bounded overflow", func(t *testing.T) {
+		defer func() {
+			panicInfo := recover()
+			if err, ok := panicInfo.(error); !ok || !errors.Is(err, core.ErrArgs) {
+				t.Fatalf("bounded ring with a non-Reject overflow policy: got panic %v, want ErrArgs", panicInfo)
+			}
+		}()
+		core.NewRuntime(runtime.NewContext(service.NewContext()), ring(core.With.TaskQueue.Unbounded(false), core.With.TaskQueue.Capacity(4), core.With.TaskQueue.Overflow(core.TaskQueueOverflow_Block))...)
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		runTaskQueueScenario(t, ring(core.With.TaskQueue.Unbounded(false), core.With.TaskQueue.Capacity(4)), func(rtCtx runtime.Context) error {
+			ret := core.Submit(rtCtx, func(rtCtx runtime.Context, _ ...any) async.Result {
+				accepted := 0
+				for range 10 {
+					switch err := core.Post(rtCtx, func(runtime.Context, ...any) {}); {
+					case err == nil:
+						accepted++
+					case !errors.Is(err, core.ErrTaskQueueFull):
+						return async.NewResult(nil, err)
+					}
+				}
+				return async.NewResult(accepted, nil)
+			}).Wait(context.Background())
+			if ret.Error != nil {
+				return ret.Error
+			}
+			if ret.Value != 4 {
+				return fmt.Errorf("bounded ring accepted %v tasks, want 4", ret.Value)
+			}
+			return nil
+		})
+	})
+}
+
+func Test_TaskQueueOverflow(t *testing.T) {
+	bounded := func(settings ...option.Setting[core.TaskQueueOptions]) []option.Setting[core.RuntimeOptions] {
+		return []option.Setting[core.RuntimeOptions]{
+			core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+			core.With.Runtime.TaskQueue(append([]option.Setting[core.TaskQueueOptions]{
+				core.With.TaskQueue.Unbounded(false),
+				core.With.TaskQueue.Capacity(4),
+			}, settings...)...),
+		}
+	}
+	stats := func(rtCtx runtime.Context) core.RuntimeTaskStats {
+		return runtime.UnsafeContext(rtCtx).Caller().(core.Runtime).Stats().Tasks
+	}
+	// fill 在 Runtime goroutine 中按序投递 n 个记录序号的 Post 任务，执行完毕后返回已执行序号与各次投递错误。
+	fill := func(rtCtx runtime.Context, n int, extra func(rtCtx runtime.Context) error) ([]int, []error, error) {
+		var executed []int
+		var errs []error
+		ret := core.Submit(rtCtx, func(rtCtx runtime.Context, _ ...any) async.Result {
+			for i := range n {
+				errs = append(errs, core.Post(rtCtx, func(runtime.Context, ...any) { executed = append(executed, i) }))
+			}
+			if extra != nil {
+				return async.NewResult(nil, extra(rtCtx))
+			}
+			return async.Result{}
+		}).Wait(context.Background())
+		if ret.Error != nil {
+			return nil, nil, ret.Error
+		}
+		ret = core.Submit(rtCtx, func(runtime.Context, ...any) async.Result {
+			return async.NewResult(slices.Clone(executed), nil)
+		}).Wait(context.Background())
+		return ret.Value.([]int), errs, ret.Error
+	}
+
+	t.Run("drop oldest", func(t *testing.T) {
+		runTaskQueueScenario(t, bounded(core.With.TaskQueue.Overflow(core.TaskQueueOverflow_DropOldest)), func(rtCtx runtime.Context) error {
+			executed, errs, err := fill(rtCtx, 6, nil)
+			if err != nil {
+				return err
+			}
+			if slices.ContainsFunc(errs, func(err error) bool { return err != nil }) {
+				return fmt.Errorf("drop oldest rejected a Post: %v", errs)
+			}
+			if !slices.Equal(executed, []int{2, 3, 4, 5}) {
+				return fmt.Errorf("drop oldest executed %v", executed)
+			}
+			if dropped := stats(rtCtx).Post.Dropped; dropped != 2 {
+				return fmt.Errorf("drop oldest dropped %d, want 2", dropped)
+			}
+			return nil
+		})
+	})
+
+	t.Run("drop newest", func(t *testing.T) {
+		runTaskQueueScenario(t, bounded(core.With.TaskQueue.Overflow(core.TaskQueueOverflow_DropNewest)), func(rtCtx runtime.Context) error {
+			executed, errs, err := fill(rtCtx, 6, nil)
+			if err != nil {
+				return err
+			}
+			if slices.ContainsFunc(errs, func(err error) bool { return err != nil }) {
+				return fmt.Errorf("drop newest rejected a Post: %v", errs)
+			}
+			if !slices.Equal(executed, []int{0, 1, 2, 3}) {
+				return fmt.Errorf("drop newest executed %v", executed)
+			}
+			if dropped := stats(rtCtx).Post.Dropped; dropped != 2 {
+				return fmt.Errorf("drop newest dropped %d, want 2", dropped)
+			}
+			return nil
+		})
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		runTaskQueueScenario(t, bounded(core.With.TaskQueue.Overflow(core.TaskQueueOverflow_Priority)), func(rtCtx runtime.Context) error {
+			var submitted async.Future
+			executed, _, err := fill(rtCtx, 4, func(rtCtx runtime.Context) error {
+				submitted = core.Submit(rtCtx, func(runtime.Context, ...any) async.Result { return async.NewResult(true, nil) })
+				if err := core.Post(rtCtx, func(runtime.Context, ...any) {}); !errors.Is(err, core.ErrTaskQueueFull) {
+					return fmt.Errorf("lowest priority Post on a full queue: %v", err)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if !slices.Equal(executed, []int{0, 1, 2}) {
+				return fmt.Errorf("priority executed %v", executed)
+			}
+			if ret := submitted.Wait(context.Background()); ret.Error != nil || ret.Value != true {
+				return fmt.Errorf("priority Submit result: %v", ret)
+			}
+			return nil
+		})
+	})
+
+	t.Run("block", func(t *testing.T) {
+		runTaskQueueScenario(t, bounded(core.With.TaskQueue.Overflow(core.TaskQueueOverflow_Block)), func(rtCtx runtime.Context) error {
+			// 暂停期间 Runtime 不执行任务，外部生产者在已满的队列上阻塞等待；BatchSize 为 1，Pause 所在任务返回后不再出队
+			rt := runtime.UnsafeContext(rtCtx).Caller().(core.Runtime)
+			if ret := core.Submit(rtCtx, func(runtime.Context, ...any) async.Result {
+				rt.Pause()
+				return async.Result{}
+			}).Wait(context.Background()); ret.Error != nil {
+				return ret.Error
+			}
+			for range 4 {
+				if err := core.Post(rtCtx, func(runtime.Context, ...any) {}); err != nil {
+					return err
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			if err := core.PostContext(ctx, rtCtx, func(runtime.Context, ...any) {}); !errors.Is(err, core.ErrTaskQueueFull) || !errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("PostContext on a full queue: %v", err)
+			}
+
+			posted := make(chan error, 1)
+			go func() { posted <- core.Post(rtCtx, func(runtime.Context, ...any) {}) }()
+			select {
+			case err := <-posted:
+				return fmt.Errorf("blocking Post returned before space was available: %v", err)
+			case <-time.After(10 * time.Millisecond):
+			}
+			rt.Resume()
+			return <-posted
+		})
+	})
+
+	t.Run("block on runtime goroutine", func(t *testing.T) {
+		runTaskQueueScenario(t, bounded(core.With.TaskQueue.Overflow(core.TaskQueueOverflow_Block)), func(rtCtx runtime.Context) error {
+			executed, errs, err := fill(rtCtx, 6, nil)
+			if err != nil {
+				return err
+			}
+			if !slices.Equal(executed, []int{0, 1, 2, 3}) {
+				return fmt.Errorf("block on runtime goroutine executed %v", executed)
+			}
+			for i, err := range errs {
+				if full := i >= 4; full != errors.Is(err, core.ErrTaskQueueFull) {
+					return fmt.Errorf("block on runtime goroutine: Post %d returned %v", i, err)
+				}
+			}
+			return nil
+		})
+	})
+
+	t.Run("watermarks", func(t *testing.T) {
+		var marks []bool
+		var mu sync.Mutex
+		runTaskQueueScenario(t, []option.Setting[core.RuntimeOptions]{
+			core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+			core.With.Runtime.TaskQueue(
+				core.With.TaskQueue.Watermarks(1, 3),
+				core.With.TaskQueue.WatermarkCB(func(_ core.Runtime, high bool, _ int) {
+					mu.Lock()
+					marks = append(marks, high)
+					mu.Unlock()
+				}),
+			),
+		}, func(rtCtx runtime.Context) error {
+			if _, _, err := fill(rtCtx, 4, nil); err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if !slices.Equal(marks, []bool{true, false}) {
+				return fmt.Errorf("watermark callbacks: %v", marks)
+			}
+			return nil
+		})
+	})
+
+	t.Run("watermarks concurrent", func(t *testing.T) {
+		var (
+			marks    []bool
+			inside   atomic.Bool
+			overlaps atomic.Int64
+		)
+		runTaskQueueScenario(t, []option.Setting[core.RuntimeOptions]{
+			core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+			core.With.Runtime.TaskQueue(
+				core.With.TaskQueue.Watermarks(1, 2),
+				core.With.TaskQueue.WatermarkCB(func(_ core.Runtime, high bool, _ int) {
+					if !inside.CompareAndSwap(false, true) {
+						overlaps.Add(1)
+						return
+					}
+					marks = append(marks, high)
+					inside.Store(false)
+				}),
+			),
+		}, func(rtCtx runtime.Context) error {
+			var wg sync.WaitGroup
+			for range 8 {
+				wg.Go(func() {
+					for range 500 {
+						core.Post(rtCtx, func(runtime.Context, ...any) {})
+					}
+				})
+			}
+			wg.Wait()
+			core.Submit(rtCtx, func(runtime.Context, ...any) async.Result { return async.Result{} }).Wait(context.Background())
+
+			if n := overlaps.Load(); n > 0 {
+				return fmt.Errorf("watermark callbacks overlapped %d times", n)
+			}
+			if len(marks) == 0 {
+				return errors.New("watermark callbacks were not called")
+			}
+			for i, high := range marks {
+				if high != (i%2 == 0) {
+					return fmt.Errorf("watermark callbacks out of order at %d: %v", i, marks)
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func Test_RuntimePauseResume(t *testing.T) {
+	waitFor := func(cond func() bool) bool {
+		for range 500 {
+			if cond() {
+				return true
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return false
+	}
+
+	t.Run("tasks", func(t *testing.T) {
+		paused := make(chan struct{}, 1)
+		var resumed atomic.Bool
+		scenario := newCoreTestScenario(3 * time.Second)
+		svcCtx := service.NewContext(
+			service.With.Context(scenario.ctx),
+			service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+				if runningEvent != service.RunningEvent_Started {
+					return
+				}
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Paused:
+								paused <- struct{}{}
+							case runtime.RunningEvent_Resumed:
+								resumed.Store(true)
+							case runtime.RunningEvent_Started:
+								rt := runtime.UnsafeContext(rtCtx).Caller().(core.Runtime)
+								go func() {
+									scenario.complete(func() error {
+										if !rt.Pause() || rt.Pause() {
+											return errors.New("Pause did not report the state change once")
+										}
+										<-paused
+
+										var executed atomic.Int64
+										for range 3 {
+											if err := core.Post(rtCtx, func(runtime.Context, ...any) { executed.Add(1) }); err != nil {
+												return err
+											}
+										}
+										time.Sleep(10 * time.Millisecond)
+										if n := executed.Load(); n != 0 {
+											return fmt.Errorf("paused runtime executed %d tasks", n)
+										}
+
+										if !rt.Step() || !waitFor(func() bool { return executed.Load() == 1 }) {
+											return fmt.Errorf("Step executed %d tasks, want 1", executed.Load())
+										}
+										time.Sleep(10 * time.Millisecond)
+										if n := executed.Load(); n != 1 {
+											return fmt.Errorf("Step executed %d tasks, want 1", n)
+										}
+
+										if !rt.Resume() || rt.Step() {
+											return errors.New("Resume did not leave the paused state")
+										}
+										if !waitFor(func() bool { return executed.Load() == 3 && resumed.Load() }) {
+											return fmt.Errorf("resumed runtime executed %d tasks, want 3", executed.Load())
+										}
+										return nil
+									}())
+								}()
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}),
+		)
+		scenario.run(t, svcCtx)
+	})
+
+	t.Run("batched tasks", func(t *testing.T) {
+		paused := make(chan struct{}, 1)
+		scenario := newCoreTestScenario(3 * time.Second)
+		svcCtx := service.NewContext(
+			service.With.Context(scenario.ctx),
+			service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+				if runningEvent != service.RunningEvent_Started {
+					return
+				}
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Paused:
+								paused <- struct{}{}
+							case runtime.RunningEvent_Started:
+								rt := runtime.UnsafeContext(rtCtx).Caller().(core.Runtime)
+								go func() {
+									scenario.complete(func() error {
+										entered, gate := make(chan struct{}), make(chan struct{})
+										if err := core.Post(rtCtx, func(runtime.Context, ...any) {
+											close(entered)
+											<-gate
+										}); err != nil {
+											return err
+										}
+										<-entered
+
+										// gate 返回后同一批次依次取出暂停任务与计数任务，暂停后必须停止出队
+										var executed atomic.Int64
+										if err := core.Post(rtCtx, func(runtime.Context, ...any) { rt.Pause() }); err != nil {
+											return err
+										}
+										for range 3 {
+											if err := core.Post(rtCtx, func(runtime.Context, ...any) { executed.Add(1) }); err != nil {
+												return err
+											}
+										}
+										close(gate)
+										<-paused
+
+										time.Sleep(10 * time.Millisecond)
+										if n := executed.Load(); n != 0 {
+											return fmt.Errorf("batch kept running %d tasks after Pause", n)
+										}
+
+										rt.Resume()
+										if !waitFor(func() bool { return executed.Load() == 3 }) {
+											return fmt.Errorf("resumed runtime executed %d tasks, want 3", executed.Load())
+										}
+										return nil
+									}())
+								}()
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+					core.With.Runtime.TaskQueue(
+						core.With.TaskQueue.Unbounded(false),
+						core.With.TaskQueue.Capacity(8),
+						core.With.TaskQueue.BatchSize(8),
+					),
+				)
+			}),
+		)
+		scenario.run(t, svcCtx)
+	})
+
+	t.Run("frames", func(t *testing.T) {
+		const pause = 60 * time.Millisecond
+		paused := make(chan struct{}, 1)
+		var (
+			frames       atomic.Int64
+			resumedAt    atomic.Int64
+			maxAfterStep atomic.Int64
+		)
+		scenario := newCoreTestScenario(3 * time.Second)
+		svcCtx := service.NewContext(
+			service.With.Context(scenario.ctx),
+			service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+				if runningEvent != service.RunningEvent_Started {
+					return
+				}
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Paused:
+								paused <- struct{}{}
+							case runtime.RunningEvent_FrameLoopBegin:
+								frames.Store(rtCtx.Frame().CurFrames())
+							case runtime.RunningEvent_FrameLoopEnd:
+								if resumedAt.Load() != 0 {
+									maxAfterStep.Store(max(maxAfterStep.Load(), int64(rtCtx.Frame().LastLoopElapseTime())))
+								}
+							case runtime.RunningEvent_Started:
+								rt := runtime.UnsafeContext(rtCtx).Caller().(core.Runtime)
+								go func() {
+									scenario.complete(func() error {
+										time.Sleep(20 * time.Millisecond)
+										rt.Pause()
+										<-paused
+
+										begun := frames.Load()
+										time.Sleep(pause / 2)
+										if n := frames.Load(); n != begun {
+											return fmt.Errorf("paused runtime advanced frames %d -> %d", begun, n)
+										}
+
+										rt.Step()
+										rt.Step()
+										if !waitFor(func() bool { return frames.Load() == begun+2 }) {
+											return fmt.Errorf("two Steps advanced frames %d -> %d", begun, frames.Load())
+										}
+										resumedAt.Store(1)
+										time.Sleep(pause / 2)
+
+										rt.Resume()
+										if !waitFor(func() bool { return frames.Load() >= begun+5 }) {
+											return errors.New("frames did not advance after Resume")
+										}
+										if elapsed := time.Duration(maxAfterStep.Load()); elapsed >= pause/2 {
+											return fmt.Errorf("frame after pause reported %s loop time", elapsed)
+										}
+										return nil
+									}())
+								}()
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.TargetFPS(200)),
+				)
+			}),
+		)
+		scenario.run(t, svcCtx)
+	})
+
+	t.Run("total frames", func(t *testing.T) {
+		var frames []int64
+		scenario := newCoreTestScenario(3 * time.Second)
+		svcCtx := service.NewContext(
+			service.With.Context(scenario.ctx),
+			service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+				if runningEvent != service.RunningEvent_Started {
+					return
+				}
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Started:
+								rt := runtime.UnsafeContext(rtCtx).Caller().(core.Runtime)
+								rt.Pause()
+								for range 10 {
+									rt.Step()
+								}
+							case runtime.RunningEvent_FrameLoopBegin:
+								frames = append(frames, rtCtx.Frame().CurFrames())
+							case runtime.RunningEvent_Terminated:
+								scenario.complete(nil)
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.TargetFPS(1), core.With.Frame.TotalFrames(5)),
+				)
+			}),
+		)
+		scenario.run(t, svcCtx)
+		if !slices.Equal(frames, []int64{0, 1, 2, 3, 4}) {
+			t.Fatalf("stepped frames: %v, want [0 1 2 3 4]", frames)
+		}
+	})
+}
+
+func Test_RuntimeTargetFPS(t *testing.T) {
+	type fpsChange struct {
+		event    runtime.RunningEvent
+		old, new float64
+	}
+
+	runFPSScenario := func(t *testing.T, frameSettings []option.Setting[core.FrameOptions], onUpdate func(), fn func(rt core.Runtime, changes <-chan fpsChange, frames *atomic.Int64) error) {
+		changes := make(chan fpsChange, 16)
+		var frames atomic.Int64
+		scenario := newCoreTestScenario(5 * time.Second)
+		svcCtx := service.NewContext(
+			service.With.Context(scenario.ctx),
+			service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+				if runningEvent != service.RunningEvent_Started {
+					return
+				}
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_TargetFPSChanged, runtime.RunningEvent_TargetFPSAdapted:
+								select {
+								case changes <- fpsChange{event: runningEvent, old: args[0].(float64), new: args[1].(float64)}:
+								default:
+								}
+							case runtime.RunningEvent_FrameUpdateBegin:
+								frames.Add(1)
+								if onUpdate != nil {
+									onUpdate()
+								}
+							case runtime.RunningEvent_Started:
+								rt := runtime.UnsafeContext(rtCtx).Caller().(core.Runtime)
+								go func() {
+									scenario.complete(fn(rt, changes, &frames))
+								}()
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(frameSettings...),
+				)
+			}),
+		)
+		scenario.run(t, svcCtx)
+	}
+
+	waitChange := func(changes <-chan fpsChange) (fpsChange, error) {
+		select {
+		case change := <-changes:
+			return change, nil
+		case <-time.After(3 * time.Second):
+			return fpsChange{}, errors.New("timed out waiting for a target FPS change")
+		}
+	}
+
+	t.Run("set", func(t *testing.T) {
+		runFPSScenario(t, []option.Setting[core.FrameOptions]{core.With.Frame.TargetFPS(10)}, nil, func(rt core.Runtime, changes <-chan fpsChange, frames *atomic.Int64) error {
+			// 四舍五入为 0 的 fps 不能被当作无修改请求而静默忽略
+			if err := func() (err error) {
+				defer func() {
+					if panicErr, ok := recover().(error); !ok || !errors.Is(panicErr, core.ErrArgs) {
+						err = fmt.Errorf("SetTargetFPS(0.4): got panic %v, want ErrArgs", panicErr)
+					}
+				}()
+				rt.SetTargetFPS(0.4)
+				return nil
+			}(); err != nil {
+				return err
+			}
+
+			if !rt.SetTargetFPS(99.6) {
+				return errors.New("SetTargetFPS reported no frame loop")
+			}
+			change, err := waitChange(changes)
+			if err != nil {
+				return err
+			}
+			if change != (fpsChange{event: runtime.RunningEvent_TargetFPSChanged, old: 10, new: 100}) {
+				return fmt.Errorf("unexpected change %+v", change)
+			}
+
+			begun := frames.Load()
+			time.Sleep(200 * time.Millisecond)
+			if n := frames.Load() - begun; n < 8 {
+				return fmt.Errorf("ran %d frames in 200ms after raising FPS to 100", n)
+			}
+			return nil
+		})
+	})
+
+	t.Run("adaptive", func(t *testing.T) {
+		var slow atomic.Bool
+		slow.Store(true)
+		settings := []option.Setting[core.FrameOptions]{
+			core.With.Frame.TargetFPS(100),
+			core.With.Frame.Adaptive(true),
+			core.With.Frame.MinFPS(20),
+		}
+		onUpdate := func() {
+			if slow.Load() {
+				time.Sleep(20 * time.Millisecond)
+			}
+		}
+		runFPSScenario(t, settings, onUpdate, func(rt core.Runtime, changes <-chan fpsChange, frames *atomic.Int64) error {
+			change, err := waitChange(changes)
+			if err != nil {
+				return err
+			}
+			if change.event != runtime.RunningEvent_TargetFPSAdapted || change.old != 100 || change.new >= 100 || change.new < 20 {
+				return fmt.Errorf("unexpected reduction %+v", change)
+			}
+
+			slow.Store(false)
+			for {
+				reduced := change.new
+				if change, err = waitChange(changes); err != nil {
+					return err
+				}
+				if change.event != runtime.RunningEvent_TargetFPSAdapted || change.old != reduced {
+					return fmt.Errorf("unexpected change %+v after %v", change, reduced)
+				}
+				if change.new > reduced {
+					return nil
+				}
+			}
+		})
+	})
+
+	t.Run("no frame", func(t *testing.T) {
+		runFPSScenario(t, []option.Setting[core.FrameOptions]{core.With.Frame.Enabled(false)}, nil, func(rt core.Runtime, changes <-chan fpsChange, frames *atomic.Int64) error {
+			if rt.SetTargetFPS(60) {
+				return errors.New("SetTargetFPS succeeded without a frame loop")
+			}
+			return nil
+		})
+	})
+}
+
+type ComponentTestFrameSlow struct {
+	ec.ComponentBehavior
+}
+
+func (c *ComponentTestFrameSlow) Update() {
+	time.Sleep(15 * time.Millisecond)
+}
+
+func Test_RuntimeFrameOverrun(t *testing.T) {
+	const frameCount = 6
+
+	scenario := newCoreTestScenario(3 * time.Second)
+	var (
+		overruns []core.FrameOverrun
+		report   core.FrameOverrunReport
+	)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				core.BuildEntityPT(ctx, "Profiled").
+					AddComponent(ComponentTestFrameSlow{}).
+					AddComponent(ComponentTestFrameUpdate{}).
+					Declare()
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Started:
+								for range 3 {
+									if _, err := core.BuildEntity(rtCtx, "Profiled").New(); err != nil {
+										scenario.complete(fmt.Errorf("create profiled entity: %w", err))
+										return
+									}
+								}
+							case runtime.RunningEvent_Terminated:
+								report = runtime.UnsafeContext(rtCtx).Caller().(core.Runtime).FrameOverruns()
+								scenario.complete(nil)
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(
+						core.With.Frame.TargetFPS(100),
+						core.With.Frame.TotalFrames(frameCount),
+						core.With.Frame.Profile(true),
+						core.With.Frame.ProfileWindow(2),
+						core.With.Frame.OverrunCB(func(rt core.Runtime, overrun core.FrameOverrun) {
+							overruns = append(overruns, overrun)
+						}),
+					),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+
+	isSlow := func(cost core.ComponentFrameCost) bool {
+		return strings.HasSuffix(cost.Prototype, "ComponentTestFrameSlow")
+	}
+
+	if len(overruns) == 0 {
+		t.Fatal("OverrunCB was not called")
+	}
+	for _, overrun := range overruns {
+		if overrun.Budget != 10*time.Millisecond || overrun.Elapsed <= overrun.Budget {
+			t.Errorf("frame %d overrun: budget %s, elapsed %s", overrun.Frame, overrun.Budget, overrun.Elapsed)
+		}
+		if len(overrun.Top) != 2 || !isSlow(overrun.Top[0]) {
+			t.Fatalf("frame %d top costs: %+v", overrun.Frame, overrun.Top)
+		}
+		if slow := overrun.Top[0]; slow.Calls != 3 || slow.Update < 45*time.Millisecond || slow.LateUpdate != 0 {
+			t.Errorf("frame %d slow component cost: %+v", overrun.Frame, slow)
+		}
+		if fast := overrun.Top[1]; fast.Calls != 6 || fast.Total() >= overrun.Top[0].Total() {
+			t.Errorf("frame %d fast component cost: %+v", overrun.Frame, fast)
+		}
+	}
+
+	if report.Overruns != int64(len(overruns)) {
+		t.Fatalf("report counted %d overruns, want %d", report.Overruns, len(overruns))
+	}
+	if want := overruns[max(0, len(overruns)-2):]; len(report.Recent) != len(want) || report.Recent[len(want)-1].Frame != want[len(want)-1].Frame {
+		t.Fatalf("report recent frames: %+v, want the last %d", report.Recent, len(want))
+	}
+	if len(report.Top) == 0 || !isSlow(report.Top[0]) || report.Top[0].Calls != int64(3*len(report.Recent)) {
+		t.Fatalf("report top costs: %+v", report.Top)
+	}
+}
+
+type ComponentTestOrderBase struct {
+	ec.ComponentBehavior
+	log *[]string
+}
+
+func (c *ComponentTestOrderBase) Awake() {
+	c.log = c.Entity().PT().Meta().Value("log").(*[]string)
+}
+
+func (c *ComponentTestOrderBase) Update() {
+	*c.log = append(*c.log, "Update "+c.Name())
+}
+
+func (c *ComponentTestOrderBase) LateUpdate() {
+	*c.log = append(*c.log, "LateUpdate "+c.Name())
+}
+
+type ComponentTestOrderView struct {
+	ComponentTestOrderBase
+}
+
+func (c *ComponentTestOrderView) UpdateOrder() int32 {
+	return ec.UpdateGroup_Presentation
+}
+
+type ComponentTestOrderAI struct {
+	ComponentTestOrderBase
+}
+
+func (c *ComponentTestOrderAI) UpdateOrder() int32 {
+	return ec.UpdateGroup_AI
+}
+
+type ComponentTestOrderInput struct {
+	ComponentTestOrderBase
+}
+
+func (c *ComponentTestOrderInput) UpdateOrder() int32 {
+	return ec.UpdateGroup_Input
+}
+
+type ComponentTestOrderPlain struct {
+	ComponentTestOrderBase
+}
+
+func Test_RuntimeUpdateOrder(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	var log []string
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("Ordered").SetMeta(map[string]any{"log": &log}),
+					pt.NewComponentDescriptor(ComponentTestOrderView{}).SetName("View"),
+					pt.NewComponentDescriptor(ComponentTestOrderAI{}).SetName("AI"),
+					pt.NewComponentDescriptor(ComponentTestOrderPlain{}).SetName("Plain"),
+					pt.NewComponentDescriptor(ComponentTestOrderInput{}).SetName("Input"),
+				)
+			case service.RunningEvent_Started:
+				plainPT := ctx.EntityLib().ComponentLib().Declare(ComponentTestOrderPlain{})
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Started:
+								for range 2 {
+									if _, err := core.BuildEntity(rtCtx, "Ordered").New(); err != nil {
+										scenario.complete(fmt.Errorf("create ordered entity: %w", err))
+										return
+									}
+								}
+							case runtime.RunningEvent_Terminated:
+								scenario.complete(nil)
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.UpdateOrders(map[string]int32{plainPT.Prototype(): ec.UpdateGroup_Physics}),
+					core.With.Runtime.Frame(
+						core.With.Frame.TargetFPS(120),
+						core.With.Frame.TotalFrames(1),
+					),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+
+	var want []string
+	for _, phase := range []string{"Update", "LateUpdate"} {
+		for _, name := range []string{"Input", "Plain", "AI", "View"} {
+			want = append(want, phase+" "+name, phase+" "+name)
+		}
+	}
+	if !slices.Equal(log, want) {
+		t.Fatalf("update order:\n got %q\nwant %q", log, want)
+	}
+}
+
+type ComponentTestThrottleLog struct {
+	frames map[uid.ID][]int64
+	late   map[uid.ID][]int64
+	times  map[uid.ID]int
+	idle   []int64
+	idles  []ec.Component
+}
+
+type ComponentTestThrottleFrames struct {
+	ec.ComponentBehavior
+	log *ComponentTestThrottleLog
+}
+
+func (c *ComponentTestThrottleFrames) UpdateInterval() ec.UpdateInterval {
+	return ec.UpdateInterval{Frames: 4}
+}
+
+func (c *ComponentTestThrottleFrames) Awake() {
+	c.log = c.Entity().PT().Meta().Value("log").(*ComponentTestThrottleLog)
+}
+
+func (c *ComponentTestThrottleFrames) Update() {
+	c.log.frames[c.Entity().ID()] = append(c.log.frames[c.Entity().ID()], runtime.Current(c).Frame().CurFrames())
+}
+
+func (c *ComponentTestThrottleFrames) LateUpdate() {
+	c.log.late[c.Entity().ID()] = append(c.log.late[c.Entity().ID()], runtime.Current(c).Frame().CurFrames())
+}
+
+type ComponentTestThrottleTime struct {
+	ec.ComponentBehavior
+	log *ComponentTestThrottleLog
+}
+
+func (c *ComponentTestThrottleTime) Awake() {
+	c.log = c.Entity().PT().Meta().Value("log").(*ComponentTestThrottleLog)
+}
+
+func (c *ComponentTestThrottleTime) Update() {
+	c.log.times[c.Entity().ID()]++
+}
+
+type ComponentTestThrottleIdle struct {
+	ec.ComponentBehavior
+	log *ComponentTestThrottleLog
+}
+
+func (c *ComponentTestThrottleIdle) Awake() {
+	c.log = c.Entity().PT().Meta().Value("log").(*ComponentTestThrottleLog)
+	c.log.idles = append(c.log.idles, c)
+}
+
+func (c *ComponentTestThrottleIdle) Update() {
+	c.log.idle = append(c.log.idle, runtime.Current(c).Frame().CurFrames())
+	if len(c.log.idle) == 1 && (!core.SuspendUpdate(c) || core.SuspendUpdate(c)) {
+		panic("SuspendUpdate did not report the state change once")
+	}
+}
+
+func Test_RuntimeUpdateInterval(t *testing.T) {
+	const (
+		entityCount = 8
+		frameCount  = 40
+		resumeFrame = 20
+	)
+
+	scenario := newCoreTestScenario(3 * time.Second)
+	log := &ComponentTestThrottleLog{
+		frames: map[uid.ID][]int64{},
+		late:   map[uid.ID][]int64{},
+		times:  map[uid.ID]int{},
+	}
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("Throttled").SetMeta(map[string]any{"log": log}),
+					ComponentTestThrottleFrames{},
+					ComponentTestThrottleTime{},
+				)
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("Idle").SetMeta(map[string]any{"log": log}),
+					ComponentTestThrottleIdle{},
+				)
+			case service.RunningEvent_Started:
+				timePT := ctx.EntityLib().ComponentLib().Declare(ComponentTestThrottleTime{})
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Started:
+								for range entityCount {
+									if _, err := core.BuildEntity(rtCtx, "Throttled").New(); err != nil {
+										scenario.complete(fmt.Errorf("create throttled entity: %w", err))
+										return
+									}
+								}
+								if _, err := core.BuildEntity(rtCtx, "Idle").New(); err != nil {
+									scenario.complete(fmt.Errorf("create idle entity: %w", err))
+								}
+							case runtime.RunningEvent_FrameUpdateBegin:
+								if rtCtx.Frame().CurFrames() == resumeFrame && len(log.idles) == 1 && !core.ResumeUpdate(log.idles[0]) {
+									scenario.complete(errors.New("ResumeUpdate did not resume the idle component"))
+								}
+							case runtime.RunningEvent_Terminated:
+								scenario.complete(nil)
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.UpdateIntervals(map[string]ec.UpdateInterval{timePT.Prototype(): {Duration: 25 * time.Millisecond}}),
+					core.With.Runtime.Frame(
+						core.With.Frame.TargetFPS(200),
+						core.With.Frame.TotalFrames(frameCount),
+					),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+
+	if len(log.frames) != entityCount {
+		t.Fatalf("frame-throttled entities updated: got %d, want %d", len(log.frames), entityCount)
+	}
+	phases := map[int64]bool{}
+	for id, frames := range log.frames {
+		if len(frames) < frameCount/4-1 || !slices.Equal(frames, log.late[id]) {
+			t.Fatalf("entity %s updated at frames %v, late updated at %v", id, frames, log.late[id])
+		}
+		for i := 1; i < len(frames); i++ {
+			if frames[i]-frames[i-1] != 4 {
+				t.Fatalf("entity %s updated at frames %v, want every 4 frames", id, frames)
+			}
+		}
+		phases[frames[0]%4] = true
+	}
+	if len(phases) < 2 {
+		t.Errorf("frame-throttled updates were not staggered: phases %v", phases)
+	}
+
+	for id, n := range log.times {
+		if n <= 0 || n >= frameCount/2 {
+			t.Errorf("time-throttled entity %s updated %d times in %d frames", id, n, frameCount)
+		}
+	}
+
+	if len(log.idle) < 2 || log.idle[1] != resumeFrame || log.idle[len(log.idle)-1] != frameCount-1 || len(log.idle) != 1+frameCount-resumeFrame {
+		t.Fatalf("idle component updated at frames %v", log.idle)
+	}
+}
+
+type ComponentTestJournalLog struct {
+	failed uid.ID
+}
+
+type ComponentTestJournal struct {
+	ec.ComponentBehavior
+}
+
+type ComponentTestJournalPanic struct {
+	ec.ComponentBehavior
+}
+
+func (c *ComponentTestJournalPanic) Awake() {
+	c.Entity().PT().Meta().Value("log").(*ComponentTestJournalLog).failed = c.Entity().ID()
+	panic("journal awake failure")
+}
+
+func Test_RuntimeLifecycleJournal(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+	log := &ComponentTestJournalLog{}
+
+	var journal *core.LifecycleJournal
+	var parentID, childID uid.ID
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare("Journal", ComponentTestJournal{})
+				ctx.EntityLib().Declare(pt.NewEntityDescriptor("JournalPanic").SetMeta(map[string]any{"log": log}),
+					ComponentTestJournalPanic{},
+				)
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.PanicHandling(true, nil),
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Started:
+								journal = runtime.UnsafeContext(rtCtx).Caller().(core.Runtime).Journal()
+							case runtime.RunningEvent_FrameUpdateBegin:
+								switch rtCtx.Frame().CurFrames() {
+								case 1:
+									parent, err := core.BuildEntity(rtCtx, "Journal").New()
+									if err != nil {
+										scenario.complete(fmt.Errorf("create parent entity: %w", err))
+										return
+									}
+									child, err := core.BuildEntity(rtCtx, "Journal").New()
+									if err != nil {
+										scenario.complete(fmt.Errorf("create child entity: %w", err))
+										return
+									}
+									parentID, childID = parent.ID(), child.ID()
+									if err := rtCtx.EntityTree().MakeRoot(parentID); err != nil {
+										scenario.complete(fmt.Errorf("make root entity: %w", err))
+										return
+									}
+									if err := rtCtx.EntityTree().AddChild(parentID, childID); err != nil {
+										scenario.complete(fmt.Errorf("add child entity: %w", err))
+									}
+								case 2:
+									core.BuildEntity(rtCtx, "JournalPanic").New()
+									if child, ok := rtCtx.EntityManager().GetEntity(childID); ok {
+										child.Destroy()
+									}
+								}
+							case runtime.RunningEvent_Terminated:
+								scenario.complete(nil)
+							}
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.JournalSize(256),
+					core.With.Runtime.Frame(
+						core.With.Frame.TargetFPS(200),
+						core.With.Frame.TotalFrames(4),
+					),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+
+	if journal == nil {
+		t.Fatal("Journal() returned nil with JournalSize set")
+	}
+
+	states := func(entityID uid.ID) []string {
+		var ret []string
+		for _, entry := range journal.EntityEntries(entityID) {
+			if entry.Kind == core.JournalKind_EntityState {
+				ret = append(ret, entry.To)
+			}
+		}
+		return ret
+	}
+
+	wantChild := []string{
+		ec.EntityState_Entered.String(),
+		ec.EntityState_Awaking.String(),
+		ec.EntityState_Starting.String(),
+		ec.EntityState_Alive.String(),
+		ec.EntityState_Leaving.String(),
+		ec.EntityState_Shutting.String(),
+		ec.EntityState_Dead.String(),
+	}
+	if got := states(childID); !slices.Equal(got, wantChild) {
+		t.Fatalf("child entity states: got %v, want %v", got, wantChild)
+	}
+
+	entries := journal.EntityEntries(childID)
+	if !slices.ContainsFunc(entries, func(entry core.JournalEntry) bool {
+		return entry.Kind == core.JournalKind_TreeNodeAdd && entry.To == parentID.String()
+	}) {
+		t.Errorf("child entity tree add not journaled: %v", entries)
+	}
+	if !slices.ContainsFunc(entries, func(entry core.JournalEntry) bool {
+		return entry.Kind == core.JournalKind_ComponentState && entry.To == ec.ComponentState_Alive.String()
+	}) {
+		t.Errorf("child component states not journaled: %v", entries)
+	}
+	for _, entry := range entries {
+		if entry.TaskID <= 0 || entry.Time.IsZero() {
+			t.Fatalf("journal entry without task or time: %+v", entry)
+		}
+	}
+
+	if log.failed.IsNil() {
+		t.Fatal("panicking component never awoke")
+	}
+	failed := journal.EntityEntries(log.failed)
+	panicIdx := slices.IndexFunc(failed, func(entry core.JournalEntry) bool {
+		return entry.Kind == core.JournalKind_ActivationPanic && entry.Error != nil && strings.Contains(entry.Error.Error(), "journal awake failure")
+	})
+	abortIdx := slices.IndexFunc(failed, func(entry core.JournalEntry) bool {
+		return entry.Kind == core.JournalKind_ActivationAborted
+	})
+	if panicIdx < 0 || abortIdx < panicIdx {
+		t.Fatalf("activation panic and abort not journaled in order: %v", failed)
+	}
+
+	var buf bytes.Buffer
+	if err := journal.WriteJSON(&buf, log.failed); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var dumped []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &dumped); err != nil {
+		t.Fatalf("unmarshal journal dump: %v", err)
+	}
+	if len(dumped) != len(failed) || dumped[panicIdx]["kind"] != core.JournalKind_ActivationPanic.String() || dumped[panicIdx]["error"] == nil {
+		t.Fatalf("unexpected journal dump: %s", buf.String())
+	}
+
+	if n := len(journal.Entries()); n <= 0 || n > 256 {
+		t.Errorf("journal holds %d entries, want within (0, 256]", n)
+	}
+	var disabled *core.LifecycleJournal
+	if disabled.Entries() != nil || disabled.WriteJSON(&buf, uid.Nil) != nil {
+		t.Error("nil journal should be empty")
+	}
+}
+
+type coreTestLogBuffer struct {
+	mutex sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (b *coreTestLogBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *coreTestLogBuffer) find(msg string) map[string]any {
+	records := b.records(msg)
+	if len(records) <= 0 {
+		return nil
+	}
+	return records[0]
+}
+
+func (b *coreTestLogBuffer) count(msg string) int {
+	return len(b.records(msg))
+}
+
+func (b *coreTestLogBuffer) records(msg string) []map[string]any {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var records []map[string]any
+	for line := range strings.Lines(b.buf.String()) {
+		var record map[string]any
+		if json.Unmarshal([]byte(line), &record) == nil && record["msg"] == msg {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+type ComponentTestLogger struct {
+	ec.ComponentBehavior
+}
+
+func (c *ComponentTestLogger) Awake() {
+	c.Entity().Logger().Info("entity awake")
+	c.Logger().Info("component awake")
+}
+
+type ComponentTestLoggerPanic struct {
+	ec.ComponentBehavior
+}
+
+func (c *ComponentTestLoggerPanic) Start() {
+	panic("logger start failure")
+}
+
+func Test_ContextLogger(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+	logBuf := &coreTestLogBuffer{}
+	logger := slog.New(slog.NewJSONHandler(logBuf, nil))
+	reportErrs := make(chan error, 4)
+
+	var setupErr error
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.Name("logsvc"),
+		service.With.Logger(logger),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare("Logged", ComponentTestLogger{})
+				ctx.EntityLib().Declare("LoggedPanic", ComponentTestLoggerPanic{})
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.Name("logrt"),
+						runtime.With.PanicHandling(true, reportErrs),
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							switch runningEvent {
+							case runtime.RunningEvent_Birth:
+								runtimeAddIn1.Install(rtCtx, &testEventRecorder{})
+							case runtime.RunningEvent_Started:
+								status, ok := rtCtx.AddInManager().GetStatusByID(runtimeAddIn1.ID)
+								if !ok {
+									setupErr = errors.New("RuntimeAddIn1 status was not registered")
+									return
+								}
+								status.Logger().Info("addin ready")
+								rtCtx.Logger().Info("runtime started")
+								if rtCtx.ReportError() != reportErrs {
+									setupErr = errors.New("ReportError does not return the configured channel")
+									return
+								}
+
+								if _, err := core.BuildEntity(rtCtx, "Logged").New(); err != nil {
+									setupErr = fmt.Errorf("create logged entity: %w", err)
+									return
+								}
+								core.BuildEntity(rtCtx, "LoggedPanic").New()
+							case runtime.RunningEvent_Terminated:
+								scenario.complete(setupErr)
 							}
-							scenario.complete(nil)
 						}),
 					),
 					core.With.Runtime.AutoRun(true),
-					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+					core.With.Runtime.Frame(
+						core.With.Frame.TargetFPS(200),
+						core.With.Frame.TotalFrames(2),
+					),
 				)
 			}
 		}),
 	)
 
 	scenario.run(t, svcCtx)
-	for _, entity := range entities {
-		if entity.State() != ec.EntityState_Destroyed {
-			t.Errorf("entity %s state after shutdown: got %s, want %s", entity.ID(), entity.State(), ec.EntityState_Destroyed)
+
+	checkAttrs := func(msg string, want map[string]string, present ...string) map[string]any {
+		t.Helper()
+		record := logBuf.find(msg)
+		if record == nil {
+			t.Fatalf("log record %q not found", msg)
 		}
+		for key, value := range want {
+			if record[key] != value {
+				t.Errorf("log record %q attribute %s: got %v, want %q", msg, key, record[key], value)
+			}
+		}
+		for _, key := range present {
+			if value, _ := record[key].(string); value == "" {
+				t.Errorf("log record %q is missing attribute %s: %v", msg, key, record)
+			}
+		}
+		return record
+	}
+
+	checkAttrs("runtime started", map[string]string{"service": "logsvc", "runtime": "logrt"})
+	checkAttrs("addin ready", map[string]string{"service": "logsvc", "runtime": "logrt"}, "addin")
+	entityRecord := checkAttrs("entity awake", map[string]string{"service": "logsvc", "runtime": "logrt", "prototype": "Logged"}, "entity_id")
+	if _, ok := entityRecord["component"]; ok {
+		t.Errorf("entity log record carries a component attribute: %v", entityRecord)
+	}
+	compRecord := checkAttrs("component awake", map[string]string{"service": "logsvc", "runtime": "logrt", "prototype": "Logged"}, "entity_id", "component")
+	if compRecord["entity_id"] != entityRecord["entity_id"] || !strings.Contains(compRecord["component"].(string), "ComponentTestLogger") {
+		t.Errorf("unexpected component log record: %v", compRecord)
+	}
+
+	panicRecord := checkAttrs("panic recovered", map[string]string{"service": "logsvc", "runtime": "logrt"}, "error", "stack")
+	if !strings.Contains(panicRecord["error"].(string), "logger start failure") || !strings.Contains(panicRecord["stack"].(string), "goroutine") {
+		t.Errorf("unexpected panic log record: %v", panicRecord)
+	}
+	if n := logBuf.count("panic recovered"); n != 1 {
+		t.Errorf("panic log records: got %d, want 1", n)
+	}
+
+	select {
+	case err := <-reportErrs:
+		var stackErr *exception.ErrorWithStack
+		if !errors.As(err, &stackErr) || !errors.Is(err, exception.ErrPanicked) || !strings.Contains(err.Error(), "logger start failure") {
+			t.Errorf("reported panic: %v", err)
+		}
+	default:
+		t.Error("recovered panic was not forwarded to ReportError")
+	}
+
+	// 未运行的上下文同样在 recover 处记录，调整日志级别后立即生效
+	level := new(slog.LevelVar)
+	level.Set(slog.LevelError + 1)
+	idleBuf := &coreTestLogBuffer{}
+	idleErrs := make(chan error, 2)
+	idleCtx := service.NewContext(
+		service.With.Logger(slog.New(slog.NewJSONHandler(idleBuf, &slog.HandlerOptions{Level: level}))),
+		service.With.PanicHandling(true, idleErrs),
+	)
+	panicking := generic.CastAction0(func() { panic("idle failure") })
+
+	panicking.Call(idleCtx.AutoRecover(), idleCtx.PanicReporter())
+	if n := idleBuf.count("panic recovered"); n != 0 {
+		t.Errorf("panic log records below the level: got %d, want 0", n)
+	}
+	level.Set(slog.LevelError)
+	panicking.Call(idleCtx.AutoRecover(), idleCtx.PanicReporter())
+	if n := idleBuf.count("panic recovered"); n != 1 {
+		t.Errorf("panic log records after raising the level: got %d, want 1", n)
+	}
+	if n := len(idleErrs); n != 2 {
+		t.Errorf("panics forwarded to ReportError: got %d, want 2", n)
 	}
 }
 
@@ -481,6 +3560,358 @@ func (c *ComponentTestEnable4) Dispose() {
 	c.events = append(c.events, "Dispose")
 }
 
+func Test_EntityDirectory(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+	const leaseTTL = 60 * time.Millisecond
+
+	dir := service.NewMemoryEntityDirectory()
+	holder := service.EntityRecord{ID: uid.New(), Prototype: "Remote", Owner: uid.New()}
+	if _, err := dir.Register(context.Background(), holder, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	serverConn, clientConn := net.Pipe()
+	server := remotedir.NewServer(dir)
+	defer server.Close()
+	go server.ServeConn(serverConn)
+	client := remotedir.NewClient(clientConn)
+	defer client.Close()
+
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+	defer watchCancel()
+	events, err := client.Watch(watchCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event := <-events; event.Type != service.EntityDirectoryEvent_Registered || event.Record.ID != holder.ID {
+		t.Fatalf("catch-up event: got %+v, want registered %s", event, holder.ID)
+	}
+
+	var entity ec.Entity
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.EntityDirectory(client),
+		service.With.EntityLeaseTTL(leaseTTL),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				core.BuildEntityPT(ctx, "Test1").AddComponent(ComponentTest1{}).Declare()
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							_, err := core.BuildEntity(rtCtx, "Test1").SetPersistID(holder.ID).New()
+							var conflict *service.EntityConflictError
+							if !errors.As(err, &conflict) || conflict.Holder.Owner != holder.Owner {
+								scenario.complete(fmt.Errorf("create conflicting entity: got %v, want conflict with %s", err, holder.Owner))
+								return
+							}
+							if _, ok := ctx.EntityManager().GetEntity(holder.ID); ok {
+								scenario.complete(errors.New("conflicting entity left in local index"))
+								return
+							}
+
+							entity, err = core.BuildEntity(rtCtx, "Test1").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+
+							go func() {
+								time.Sleep(5 * leaseTTL)
+								record, ok, err := ctx.EntityManager().LookupEntity(scenario.ctx, entity.ID())
+								if err != nil || !ok {
+									scenario.complete(fmt.Errorf("lookup entity after lease renewals: found=%v, err=%v", ok, err))
+									return
+								}
+								if record.Owner != ctx.ID() || record.Prototype != "Test1" || record.Expires.IsZero() {
+									scenario.complete(fmt.Errorf("unexpected entity record %+v", record))
+									return
+								}
+								scenario.complete(nil)
+							}()
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+
+	var got []service.EntityDirectoryEventType
+	for len(got) < 2 {
+		select {
+		case event := <-events:
+			if event.Record.ID == entity.ID() {
+				got = append(got, event.Type)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("directory events for entity %s: got %v", entity.ID(), got)
+		}
+	}
+	requireExact(t, got, []service.EntityDirectoryEventType{service.EntityDirectoryEvent_Registered, service.EntityDirectoryEvent_Deregistered})
+
+	expiring := service.EntityRecord{ID: uid.New(), Prototype: "Remote", Owner: holder.Owner}
+	if _, err := client.Register(context.Background(), expiring, leaseTTL); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.KeepAlive(context.Background(), expiring.ID, uid.New(), leaseTTL); !errors.Is(err, service.ErrEntityLeaseLost) {
+		t.Fatalf("keepalive by non-owner: got %v, want %v", err, service.ErrEntityLeaseLost)
+	}
+	for {
+		select {
+		case event := <-events:
+			if event.Record.ID != expiring.ID || event.Type != service.EntityDirectoryEvent_Expired {
+				continue
+			}
+			if _, ok, _ := client.Lookup(context.Background(), expiring.ID); ok {
+				t.Fatalf("expired entity %s still registered", expiring.ID)
+			}
+			return
+		case <-time.After(time.Second):
+			t.Fatalf("entity %s lease did not expire", expiring.ID)
+		}
+	}
+}
+
+type testGatedEntityDirectory struct {
+	service.EntityDirectory
+	released      atomic.Bool
+	registering   chan uid.ID
+	registered    chan struct{}
+	deregistering chan uid.ID
+	deregistered  chan struct{}
+}
+
+func (dir *testGatedEntityDirectory) Register(ctx context.Context, record service.EntityRecord, ttl time.Duration) (service.EntityRecord, error) {
+	if dir.released.Load() {
+		return dir.EntityDirectory.Register(ctx, record, ttl)
+	}
+	select {
+	case dir.registering <- record.ID:
+	case <-ctx.Done():
+		return service.EntityRecord{}, ctx.Err()
+	}
+	select {
+	case <-dir.registered:
+	case <-ctx.Done():
+		return service.EntityRecord{}, ctx.Err()
+	}
+	return dir.EntityDirectory.Register(ctx, record, ttl)
+}
+
+func (dir *testGatedEntityDirectory) Deregister(ctx context.Context, id, owner uid.ID) error {
+	if dir.released.Load() {
+		return dir.EntityDirectory.Deregister(ctx, id, owner)
+	}
+	select {
+	case dir.deregistering <- id:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-dir.deregistered:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return dir.EntityDirectory.Deregister(ctx, id, owner)
+}
+
+func Test_EntityDirectoryRegistrationOrder(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	dir := &testGatedEntityDirectory{
+		EntityDirectory: service.NewMemoryEntityDirectory(),
+		registering:     make(chan uid.ID),
+		registered:      make(chan struct{}),
+		deregistering:   make(chan uid.ID),
+		deregistered:    make(chan struct{}),
+	}
+	id := uid.New()
+	var destroyed atomic.Bool
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.EntityDirectory(dir),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				core.BuildEntityPT(ctx, "Test1").AddComponent(ComponentTest1{}).Declare()
+			case service.RunningEvent_Started:
+				go func() {
+					<-dir.registering
+					if _, ok := ctx.EntityManager().GetEntity(id); ok {
+						scenario.complete(errors.New("entity is visible before its registration succeeded"))
+						return
+					}
+					dir.registered <- struct{}{}
+
+					<-dir.deregistering
+					for !destroyed.Load() {
+						if scenario.ctx.Err() != nil {
+							return
+						}
+						time.Sleep(time.Millisecond)
+					}
+					if _, ok, _ := dir.EntityDirectory.Lookup(scenario.ctx, id); !ok {
+						scenario.complete(errors.New("entity record removed before deregistration completed"))
+						return
+					}
+					time.Sleep(20 * time.Millisecond)
+					dir.deregistered <- struct{}{}
+
+					<-dir.registering
+					dir.released.Store(true)
+					dir.registered <- struct{}{}
+				}()
+
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							entity, err := core.BuildEntity(rtCtx, "Test1").SetPersistID(id).New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+							if _, ok := ctx.EntityManager().GetEntity(id); !ok {
+								scenario.complete(errors.New("registered entity is not visible"))
+								return
+							}
+
+							entity.Destroy()
+							destroyed.Store(true)
+							if _, ok := ctx.EntityManager().GetEntity(id); ok {
+								scenario.complete(errors.New("destroyed entity is still visible"))
+								return
+							}
+
+							if _, err := core.BuildEntity(rtCtx, "Test1").SetPersistID(id).New(); err != nil {
+								scenario.complete(fmt.Errorf("recreate entity: %w", err))
+								return
+							}
+							record, ok, err := ctx.EntityManager().LookupEntity(scenario.ctx, id)
+							if err != nil || !ok || record.Owner != ctx.ID() {
+								scenario.complete(fmt.Errorf("recreated entity record: %+v found=%v err=%v", record, ok, err))
+								return
+							}
+							scenario.complete(nil)
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
+func Test_GlobalEntityIndex(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				core.BuildEntityPT(ctx, "Player").
+					SetIndexedMeta("account_id", "room_code", "account_id").
+					SetMeta(map[string]any{"room_code": "lobby"}).
+					AddComponent(ComponentTest1{}).
+					Declare()
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							var players []ec.Entity
+							for i, room := range []string{"lobby", "r1", "lobby"} {
+								player, err := core.BuildEntity(rtCtx, "Player").
+									SetMeta(map[string]any{"account_id": i + 1, "room_code": room}).
+									New()
+								if err != nil {
+									scenario.complete(fmt.Errorf("create player %d: %w", i+1, err))
+									return
+								}
+								players = append(players, player)
+							}
+							if _, err := core.BuildEntity(rtCtx, "Player").
+								SetScope(ec.Scope_Local).
+								SetMeta(map[string]any{"account_id": 9}).
+								New(); err != nil {
+								scenario.complete(fmt.Errorf("create local player: %w", err))
+								return
+							}
+
+							entityManager := ctx.EntityManager()
+							ids := func(entities []ec.ConcurrentEntity) []uid.ID {
+								return pie.Map(entities, func(entity ec.ConcurrentEntity) uid.ID { return entity.ID() })
+							}
+
+							if got, want := ids(entityManager.GetEntitiesByPrototype("Player")), []uid.ID{players[0].ID(), players[1].ID(), players[2].ID()}; !slices.Equal(got, want) {
+								scenario.complete(fmt.Errorf("players by prototype: got %v, want %v", got, want))
+								return
+							}
+							if got, want := ids(entityManager.GetEntitiesByMeta("room_code", "lobby")), []uid.ID{players[0].ID(), players[2].ID()}; !slices.Equal(got, want) {
+								scenario.complete(fmt.Errorf("players in lobby: got %v, want %v", got, want))
+								return
+							}
+							if player, ok := entityManager.GetEntityByMeta("account_id", 2); !ok || player.ID() != players[1].ID() {
+								scenario.complete(fmt.Errorf("player for account 2: got %v, want %s", player, players[1].ID()))
+								return
+							}
+							if _, ok := entityManager.GetEntityByMeta("account_id", 9); ok {
+								scenario.complete(errors.New("local player was indexed globally"))
+								return
+							}
+							if _, ok := entityManager.GetEntityByMeta("account_id", "2"); ok {
+								scenario.complete(errors.New("meta index matched a value of a different type"))
+								return
+							}
+
+							players[1].Destroy()
+							core.Post(rtCtx, func(ctx runtime.Context, _ ...any) {
+								if _, ok := entityManager.GetEntityByMeta("account_id", 2); ok {
+									scenario.complete(errors.New("destroyed player still indexed by account"))
+									return
+								}
+								if got := entityManager.GetEntitiesByMeta("room_code", "r1"); len(got) != 0 {
+									scenario.complete(fmt.Errorf("destroyed player still indexed by room: %v", got))
+									return
+								}
+								if got := len(entityManager.GetEntitiesByPrototype("Player")); got != 2 {
+									scenario.complete(fmt.Errorf("players by prototype after destroy: got %d, want 2", got))
+									return
+								}
+								scenario.complete(nil)
+							})
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
 func Test_EntityComponentEnable(t *testing.T) {
 	scenario := newCoreTestScenario(3 * time.Second)
 	var (
@@ -1297,6 +4728,89 @@ func Test_CreateEntityFrameUpdate(t *testing.T) {
 	}
 }
 
+func Test_UpgradeEntityOnRedeclare(t *testing.T) {
+	scenario := newCoreTestScenario(3 * time.Second)
+	reportErrs := make(chan error, 8)
+
+	svcCtx := service.NewContext(
+		service.With.Context(scenario.ctx),
+		service.With.RunningEventCB(func(ctx service.Context, runningEvent service.RunningEvent, args ...any) {
+			switch runningEvent {
+			case service.RunningEvent_Birth:
+				ctx.EntityLib().Declare("Upgrade",
+					pt.NewComponentDescriptor(ComponentTest1{}).SetName("A").SetRemovable(true),
+					pt.NewComponentDescriptor(ComponentTest2{}).SetName("B"),
+				)
+			case service.RunningEvent_Started:
+				core.NewRuntime(
+					runtime.NewContext(ctx,
+						runtime.With.PanicHandling(false, reportErrs),
+						runtime.With.RunningEventCB(func(rtCtx runtime.Context, runningEvent runtime.RunningEvent, args ...any) {
+							if runningEvent != runtime.RunningEvent_Started {
+								return
+							}
+
+							entity, err := core.BuildEntity(rtCtx, "Upgrade").New()
+							if err != nil {
+								scenario.complete(fmt.Errorf("create entity: %w", err))
+								return
+							}
+
+							entityPT := ctx.EntityLib().Declare("Upgrade",
+								pt.NewComponentDescriptor(ComponentTest3{}).SetName("C"),
+							)
+							if entityPT.Version() != 2 {
+								scenario.complete(fmt.Errorf("redeclared prototype version: got %d, want 2", entityPT.Version()))
+								return
+							}
+
+							go func() {
+								for scenario.ctx.Err() == nil {
+									rtCtx.SubmitVoid(func(runtime.Context, ...any) {
+										if entity.PT().Version() != entityPT.Version() {
+											return
+										}
+										if entity.GetComponent("A") != nil {
+											scenario.complete(fmt.Errorf("removable builtin component A was not removed"))
+											return
+										}
+										if entity.GetComponent("B") == nil {
+											scenario.complete(fmt.Errorf("non-removable builtin component B was removed"))
+											return
+										}
+										comp := entity.GetComponent("C")
+										if comp == nil || comp.State() != ec.ComponentState_Alive {
+											scenario.complete(fmt.Errorf("builtin component C was not activated"))
+											return
+										}
+										select {
+										case err := <-reportErrs:
+											if !errors.Is(err, core.ErrRuntime) || !strings.Contains(err.Error(), `builtin component "B" is not removable`) {
+												scenario.complete(fmt.Errorf("non-removable builtin component report: got %v", err))
+												return
+											}
+										default:
+											scenario.complete(fmt.Errorf("non-removable builtin component B was not reported"))
+											return
+										}
+										scenario.complete(nil)
+									})
+									time.Sleep(10 * time.Millisecond)
+								}
+							}()
+						}),
+					),
+					core.With.Runtime.AutoRun(true),
+					core.With.Runtime.Frame(core.With.Frame.Enabled(false)),
+					core.With.Runtime.UpgradeEntityOnRedeclare(true),
+				)
+			}
+		}),
+	)
+
+	scenario.run(t, svcCtx)
+}
+
 type testEventRecorder struct {
 	mutex  sync.Mutex
 	events []string
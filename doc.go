@@ -34,8 +34,13 @@ Package core 把 service、runtime、ec、event 和 extension 等子包串联成
 
   - 实体、组件、插件的生命周期接口；
   - Submit/Post Actor 邮箱调度，以及保留 Delegate/DelegateVoid 的对应变体；
-  - Scope/Spawn 结构化后台任务与 ContinueOn Runtime 续体；
-  - After、Every 等定时和连续流入口；
+  - SubmitContext/PostContext 在阻塞溢出策略下以调用方 ctx 限制入队等待；
+  - SubmitTyped/ContinueOnTyped 以 async.TypedFuture 交付类型化结果；
+  - CallComponent/PostComponent 按名称与类型调用其他 Runtime 中实体的组件；
+  - Ask/Tell 按消息类型将请求派发给组件上登记的消息处理器；
+  - Scope/Spawn 结构化后台任务、Retry 退避重试与 ContinueOn Runtime 续体；
+  - After、Every 等定时和连续流入口，以 runtime.Context 调用时由 Runtime 分层时间轮驱动；
+  - 在 Runtime goroutine 中回调、随组件与实体生命周期自动停止的 AfterFunc、EveryFunc 与 AfterFrames 定时器；
   - Service、Runtime、Frame 与 TaskQueue 的选项构造器；
   - 面向框架集成与高级扩展场景的 unsafe 辅助入口。
 
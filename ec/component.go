@@ -79,6 +79,10 @@ type iComponent interface {
 	managedRuntimeUpdateHandle(updateHandle event.Handle)
 	managedRuntimeLateUpdateHandle(lateUpdateHandle event.Handle)
 	managedUnbindRuntimeHandles()
+	setUpdateSuspended(b bool)
+	getUpdateSuspended() bool
+	recycle(zeroInstance bool)
+	renew()
 }
 
 const (
@@ -88,12 +92,12 @@ const (
 
 // ComponentBehavior 提供 Component 的默认实现，扩展组件时应将其匿名嵌入自定义结构体。
 type ComponentBehavior struct {
-	id                    uid.ID
-	builtin               *BuiltinComponent
-	name                  string
-	entity                Entity
-	asyncScope            atomic.Pointer[componentAsyncScopeState]
-	instance              Component
+	incarnation atomic.Pointer[_ComponentIncarnation]
+	_ComponentBody
+}
+
+// _ComponentBody 保存只在所属 Runtime 运行协程中访问的组件状态，回收与复用组件时整体重置。
+type _ComponentBody struct {
 	state                 ComponentState
 	reflected             reflect.Value
 	removable             bool
@@ -104,32 +108,29 @@ type ComponentBehavior struct {
 	attachedVersion       int64
 	managedHandles        event.ManagedHandles
 	managedRuntimeHandles [2]event.Handle
-	stringerCache         atomic.Pointer[string]
+	updateSuspended       bool
 
 	componentEventTab componentEventTab
 }
 
 // ID 返回组件 ID。
 func (comp *ComponentBehavior) ID() uid.ID {
-	return comp.id
+	return comp.getIncarnation().ID()
 }
 
 // Builtin 返回组件的原型描述；未绑定组件原型时返回空描述。
 func (comp *ComponentBehavior) Builtin() BuiltinComponent {
-	if comp.builtin == nil {
-		return *noneBuiltinComponent
-	}
-	return *comp.builtin
+	return *comp.getIncarnation().getBuiltin()
 }
 
 // Name 返回组件在实体中的名称。
 func (comp *ComponentBehavior) Name() string {
-	return comp.name
+	return comp.getIncarnation().Name()
 }
 
 // Entity 返回组件所依附的实体。
 func (comp *ComponentBehavior) Entity() Entity {
-	return comp.entity
+	return comp.getIncarnation().entity
 }
 
 // State 返回当前生命周期状态。
@@ -142,7 +143,7 @@ func (comp *ComponentBehavior) Reflected() reflect.Value {
 	if comp.reflected.IsValid() {
 		return comp.reflected
 	}
-	comp.reflected = reflect.ValueOf(comp.instance)
+	comp.reflected = reflect.ValueOf(comp.getInstance())
 	return comp.reflected
 }
 
@@ -169,10 +170,10 @@ func (comp *ComponentBehavior) SetEnabled(b bool) {
 		}
 		comp.enabled = b
 
-		_EmitEventComponentEnableChanged(comp, comp.instance, b)
+		_EmitEventComponentEnableChanged(comp, comp.getInstance(), b)
 
-		if comp.entity != nil {
-			comp.entity.onComponentEnableChangedIfVersion(comp.attachedIndex, comp.attachedVersion)
+		if entity := comp.Entity(); entity != nil {
+			entity.onComponentEnableChangedIfVersion(comp.attachedIndex, comp.attachedVersion)
 		}
 	})
 }
@@ -194,10 +195,10 @@ func (comp *ComponentBehavior) Destroy() {
 			return
 		}
 
-		_EmitEventComponentDestroy(comp, comp.instance)
+		_EmitEventComponentDestroy(comp, comp.getInstance())
 
-		if comp.entity != nil {
-			comp.entity.onComponentDestroyIfVersion(comp.attachedIndex, comp.attachedVersion)
+		if entity := comp.Entity(); entity != nil {
+			entity.onComponentDestroyIfVersion(comp.attachedIndex, comp.attachedVersion)
 		}
 	})
 }
@@ -215,23 +216,25 @@ func (comp *ComponentBehavior) EventComponentDestroy() event.IEvent {
 
 // CurrentContextCache 返回所属实体的当前上下文接口缓存。
 func (comp *ComponentBehavior) CurrentContextCache() iface.Cache {
-	return comp.entity.CurrentContextCache()
+	return comp.Entity().CurrentContextCache()
 }
 
 func (comp *ComponentBehavior) init(name string, entity Entity, instance Component) {
-	comp.name = name
-	comp.entity = entity
-	comp.instance = instance
+	inc := comp.liveIncarnation()
+	inc.name = name
+	inc.entity = entity
+	inc.entityRef = ConcurrentRef(entity)
+	inc.instance = instance
 	comp.removable = comp.Builtin().Removable
 	comp.enabled = true
 }
 
 func (comp *ComponentBehavior) setID(id uid.ID) {
-	comp.id = id
+	comp.liveIncarnation().id = id
 }
 
 func (comp *ComponentBehavior) setBuiltin(builtin *BuiltinComponent) {
-	comp.builtin = builtin
+	comp.liveIncarnation().builtin.Store(builtin)
 }
 
 func (comp *ComponentBehavior) setState(state ComponentState) {
@@ -260,7 +263,7 @@ func (comp *ComponentBehavior) setState(state ComponentState) {
 
 	switch comp.state {
 	case ComponentState_Dead:
-		comp.closeAsyncScope()
+		comp.getIncarnation().closeAsyncScope()
 		comp.componentEventTab.SetEnabled(false)
 	case ComponentState_Destroyed:
 		comp.managedHandles.UnbindAllEventHandles()
@@ -306,3 +309,29 @@ func (comp *ComponentBehavior) managedRuntimeLateUpdateHandle(lateUpdateHandle e
 func (comp *ComponentBehavior) managedUnbindRuntimeHandles() {
 	event.UnbindHandles(comp.managedRuntimeHandles[:])
 }
+
+func (comp *ComponentBehavior) setUpdateSuspended(b bool) {
+	comp.updateSuspended = b
+}
+
+func (comp *ComponentBehavior) getUpdateSuspended() bool {
+	return comp.updateSuspended
+}
+
+func (comp *ComponentBehavior) recycle(zeroInstance bool) {
+	state := comp.state
+
+	if zeroInstance {
+		zeroInstanceExcept(reflect.ValueOf(comp.getInstance()).Elem(), &comp.incarnation)
+	}
+
+	// incarnation 保持不变，旧引用仍然观察到组件已销毁；仅保留回收所需的状态
+	comp._ComponentBody = _ComponentBody{
+		state: state,
+	}
+}
+
+func (comp *ComponentBehavior) renew() {
+	comp._ComponentBody = _ComponentBody{}
+	comp.incarnation.Store(&_ComponentIncarnation{})
+}
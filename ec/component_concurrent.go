@@ -21,6 +21,7 @@ package ec
 
 import (
 	"fmt"
+	"log/slog"
 
 	"git.golaxy.org/core/utils/async"
 	"git.golaxy.org/core/utils/corectx"
@@ -34,6 +35,9 @@ import (
 // 此接口；提前调用依赖 Runtime Context 的方法属于未定义行为。AsyncScope 在所属
 // Entity Context 绑定前返回 nil，String 在 Runtime 完成组件身份初始化前返回空字符串。
 //
+// 启用实体池的组件随实体复用后，直接持有的组件实例指向新的组件；跨 goroutine 长期持有组件时
+// 应使用 ConcurrentComponentRef 取得绑定本次生命周期的引用。
+//
 // 该视图不暴露 State、Enabled、Entity、Destroy 等 Runtime 局部能力。需要读取或修改
 // 这些状态时，应通过 Submit、Post 或 ContinueOn 回到组件所属 Runtime。
 type ConcurrentComponent interface {
@@ -46,107 +50,55 @@ type ConcurrentComponent interface {
 	ID() uid.ID
 	// Name 返回组件在 Entity 中的名称。
 	Name() string
+	// Logger 返回在所属 Entity 日志记录器上附带 component 属性的日志记录器。
+	Logger() *slog.Logger
 }
 
 type iConcurrentComponent interface {
 	getInstance() Component
+	getIncarnation() *_ComponentIncarnation
 }
 
-// componentAsyncScopeState 发布后不可变；nil 指针表示尚未创建且仍可用。
-type componentAsyncScopeState struct {
-	scope  *async.Scope
-	closed bool
-}
-
-var closedComponentAsyncScopeState = &componentAsyncScopeState{closed: true}
-
 // ConcurrentContextCache 返回所属 Entity 的并发 Runtime 上下文接口缓存。
 func (comp *ComponentBehavior) ConcurrentContextCache() iface.Cache {
-	return comp.entity.ConcurrentContextCache()
+	return comp.getIncarnation().ConcurrentContextCache()
 }
 
 // AsyncScope 返回绑定组件 Lifetime 的后台任务作用域，并在首次访问时懒创建。
 // Scope 在组件从 Entity 移除或随 Entity 销毁时关闭；SetEnabled(false) 不会关闭它。
 // 所属 Entity 尚未绑定 Runtime Context 时返回 nil；组件已关闭后首次访问会返回已关闭的 Scope。
 func (comp *ComponentBehavior) AsyncScope() *async.Scope {
-	for {
-		state := comp.asyncScope.Load()
-		if state != nil && state.scope != nil {
-			return state.scope
-		}
-
-		if comp.entity == nil {
-			return nil
-		}
-		entityScope := comp.entity.AsyncScope()
-		if entityScope == nil {
-			return nil
-		}
-
-		asyncScope := async.NewScope(entityScope.Context())
-		closed := state != nil && state.closed
-		if closed {
-			asyncScope.Close()
-		}
-
-		newState := &componentAsyncScopeState{
-			scope:  asyncScope,
-			closed: closed,
-		}
-		if comp.asyncScope.CompareAndSwap(state, newState) {
-			return asyncScope
-		}
-
-		asyncScope.Close()
-	}
+	return comp.getIncarnation().AsyncScope()
 }
 
 // String 返回包含组件、实体及原型标识的 JSON 文本；组件尚未完成 Runtime 初始化时返回空字符串。
 func (comp *ComponentBehavior) String() string {
-	if cached := comp.stringerCache.Load(); cached != nil {
-		return *cached
-	}
-
-	if comp.entity == nil || comp.id.IsNil() {
-		return ""
-	}
-	entityScope := comp.entity.AsyncScope()
-	if entityScope == nil {
-		return ""
-	}
+	return comp.getIncarnation().String()
+}
 
-	value := fmt.Sprintf(`{"id":%q,"entity_id":%q,"name":%q,"prototype":%q}`, comp.ID(), comp.Entity().ID(), comp.Name(), comp.Builtin().PT.Prototype())
-	if comp.stringerCache.CompareAndSwap(nil, &value) {
-		return value
-	}
-	return *comp.stringerCache.Load()
+// Logger 返回在所属 Entity 日志记录器上附带 component 属性的日志记录器；
+// 所属 Entity 尚未绑定 Runtime Context 时返回丢弃全部输出的日志记录器。
+func (comp *ComponentBehavior) Logger() *slog.Logger {
+	return comp.getIncarnation().Logger()
 }
 
 func (comp *ComponentBehavior) getInstance() Component {
-	return comp.instance
+	return comp.getIncarnation().instance
 }
 
-func (comp *ComponentBehavior) closeAsyncScope() {
-	for {
-		state := comp.asyncScope.Load()
-		if state == nil {
-			if comp.asyncScope.CompareAndSwap(nil, closedComponentAsyncScopeState) {
-				return
-			}
-			continue
-		}
-		if state.closed {
-			return
-		}
+func (comp *ComponentBehavior) getIncarnation() *_ComponentIncarnation {
+	if inc := comp.incarnation.Load(); inc != nil {
+		return inc
+	}
+	return noneComponentIncarnation
+}
 
-		// 先关闭实际 Scope 再发布关闭状态，避免读取方看到关闭标记时 Scope 仍可接收任务。
-		state.scope.Close()
-		closedState := &componentAsyncScopeState{
-			scope:  state.scope,
-			closed: true,
-		}
-		if comp.asyncScope.CompareAndSwap(state, closedState) {
-			return
-		}
+// liveIncarnation 返回供运行协程写入的 incarnation，尚未分配时创建。
+func (comp *ComponentBehavior) liveIncarnation() *_ComponentIncarnation {
+	inc := comp.incarnation.Load()
+	if inc == nil {
+		inc = &_ComponentIncarnation{}
+		comp.incarnation.Store(inc)
 	}
+	return inc
 }
@@ -23,6 +23,7 @@ package ec
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IComponentEventTab interface {
@@ -38,9 +39,9 @@ var (
 
 type componentEventTab [2]event.Event
 
-func (eventTab *componentEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *componentEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
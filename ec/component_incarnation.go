@@ -0,0 +1,203 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package ec
+
+import (
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+
+	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/iface"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// _ComponentIncarnation 保存组件一次加入实体到回收之间可跨协程读取的身份与上下文。
+// 组件从实体池复用时分配新的 incarnation，旧 incarnation 不再被修改，持有它的旧引用始终观察到已销毁的旧组件。
+type _ComponentIncarnation struct {
+	id            uid.ID
+	name          string
+	builtin       atomic.Pointer[BuiltinComponent]
+	entity        Entity
+	entityRef     ConcurrentEntity
+	instance      Component
+	asyncScope    atomic.Pointer[componentAsyncScopeState]
+	stringerCache atomic.Pointer[string]
+	loggerCache   atomic.Pointer[slog.Logger]
+}
+
+var noneComponentIncarnation = &_ComponentIncarnation{}
+
+// componentAsyncScopeState 发布后不可变；nil 指针表示尚未创建且仍可用。
+type componentAsyncScopeState struct {
+	scope  *async.Scope
+	closed bool
+}
+
+var closedComponentAsyncScopeState = &componentAsyncScopeState{closed: true}
+
+// ID 返回组件 ID。
+func (inc *_ComponentIncarnation) ID() uid.ID {
+	return inc.id
+}
+
+// Name 返回组件在实体中的名称。
+func (inc *_ComponentIncarnation) Name() string {
+	return inc.name
+}
+
+// ConcurrentContextCache 返回所属 Entity 的并发 Runtime 上下文接口缓存。
+func (inc *_ComponentIncarnation) ConcurrentContextCache() iface.Cache {
+	return inc.entityRef.ConcurrentContextCache()
+}
+
+// AsyncScope 返回绑定组件 Lifetime 的后台任务作用域，并在首次访问时懒创建。
+// 所属 Entity 尚未绑定 Runtime Context 时返回 nil；组件已关闭后首次访问会返回已关闭的 Scope。
+func (inc *_ComponentIncarnation) AsyncScope() *async.Scope {
+	for {
+		state := inc.asyncScope.Load()
+		if state != nil && state.scope != nil {
+			return state.scope
+		}
+
+		if inc.entityRef == nil {
+			return nil
+		}
+		entityScope := inc.entityRef.AsyncScope()
+		if entityScope == nil {
+			return nil
+		}
+
+		asyncScope := async.NewScope(entityScope.Context())
+		closed := state != nil && state.closed
+		if closed {
+			asyncScope.Close()
+		}
+
+		newState := &componentAsyncScopeState{
+			scope:  asyncScope,
+			closed: closed,
+		}
+		if inc.asyncScope.CompareAndSwap(state, newState) {
+			return asyncScope
+		}
+
+		asyncScope.Close()
+	}
+}
+
+// String 返回包含组件、实体及原型标识的 JSON 文本；组件尚未完成 Runtime 初始化时返回空字符串。
+func (inc *_ComponentIncarnation) String() string {
+	if cached := inc.stringerCache.Load(); cached != nil {
+		return *cached
+	}
+
+	if inc.entityRef == nil || inc.id.IsNil() {
+		return ""
+	}
+	if inc.entityRef.AsyncScope() == nil {
+		return ""
+	}
+
+	value := fmt.Sprintf(`{"id":%q,"entity_id":%q,"name":%q,"prototype":%q}`, inc.ID(), inc.entityRef.ID(), inc.Name(), inc.getBuiltin().PT.Prototype())
+	if inc.stringerCache.CompareAndSwap(nil, &value) {
+		return value
+	}
+	return *inc.stringerCache.Load()
+}
+
+// Logger 返回在所属 Entity 日志记录器上附带 component 属性的日志记录器；
+// 所属 Entity 尚未绑定 Runtime Context 时返回丢弃全部输出的日志记录器。
+func (inc *_ComponentIncarnation) Logger() *slog.Logger {
+	if cached := inc.loggerCache.Load(); cached != nil {
+		return cached
+	}
+
+	if inc.entityRef == nil || inc.entityRef.AsyncScope() == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+
+	logger := inc.entityRef.Logger().With("component", inc.Name())
+	if inc.loggerCache.CompareAndSwap(nil, logger) {
+		return logger
+	}
+	return inc.loggerCache.Load()
+}
+
+func (inc *_ComponentIncarnation) getBuiltin() *BuiltinComponent {
+	if builtin := inc.builtin.Load(); builtin != nil {
+		return builtin
+	}
+	return noneBuiltinComponent
+}
+
+func (inc *_ComponentIncarnation) closeAsyncScope() {
+	for {
+		state := inc.asyncScope.Load()
+		if state == nil {
+			if inc.asyncScope.CompareAndSwap(nil, closedComponentAsyncScopeState) {
+				return
+			}
+			continue
+		}
+		if state.closed {
+			return
+		}
+
+		// 先关闭实际 Scope 再发布关闭状态，避免读取方看到关闭标记时 Scope 仍可接收任务。
+		state.scope.Close()
+		closedState := &componentAsyncScopeState{
+			scope:  state.scope,
+			closed: true,
+		}
+		if inc.asyncScope.CompareAndSwap(state, closedState) {
+			return
+		}
+	}
+}
+
+// ConcurrentComponentRef 返回绑定组件当前生命周期的 ConcurrentComponent 引用。
+//
+// 启用实体池的组件随实体复用后，直接持有的组件实例指向新的组件；跨 goroutine 长期持有组件时
+// 应使用 ConcurrentComponentRef。引用只读取本次生命周期的身份与上下文，组件被复用后，
+// 经引用取得的 AsyncScope 已关闭，不会把后台任务投递到新的组件上。
+func ConcurrentComponentRef(comp ConcurrentComponent) ConcurrentComponent {
+	if comp == nil {
+		return nil
+	}
+	if ref, ok := comp.(_ConcurrentComponentRef); ok {
+		return ref
+	}
+	return _ConcurrentComponentRef{
+		_ComponentIncarnation: comp.getIncarnation(),
+	}
+}
+
+type _ConcurrentComponentRef struct {
+	*_ComponentIncarnation
+}
+
+func (ref _ConcurrentComponentRef) getInstance() Component {
+	return ref.instance
+}
+
+func (ref _ConcurrentComponentRef) getIncarnation() *_ComponentIncarnation {
+	return ref._ComponentIncarnation
+}
@@ -20,12 +20,10 @@
 package ec
 
 import (
-	"context"
 	"reflect"
 	"sync/atomic"
 
 	"git.golaxy.org/core/event"
-	"git.golaxy.org/core/utils/async"
 	"git.golaxy.org/core/utils/corectx"
 	"git.golaxy.org/core/utils/generic"
 	"git.golaxy.org/core/utils/iface"
@@ -100,6 +98,8 @@ type iEntity interface {
 	managedRuntimeUpdateHandle(updateHandle event.Handle)
 	managedRuntimeLateUpdateHandle(lateUpdateHandle event.Handle)
 	managedUnbindRuntimeHandles()
+	recycle(zeroInstance bool)
+	renew(options EntityOptions)
 }
 
 const (
@@ -108,12 +108,13 @@ const (
 
 // EntityBehavior 提供 Entity 的默认实现，扩展实体时应将其匿名嵌入自定义结构体。
 type EntityBehavior struct {
-	context.Context
+	incarnation atomic.Pointer[_EntityIncarnation]
+	_EntityBody
+}
+
+// _EntityBody 保存只在所属 Runtime 运行协程中访问的实体状态，回收与复用实体时整体重置。
+type _EntityBody struct {
 	options               EntityOptions
-	prototype             EntityPT
-	asyncScope            *async.Scope
-	terminated            async.Completer
-	runtimeCtx            runtimeContext
 	componentNameIndex    generic.SliceMap[string, int]
 	componentList         generic.FreeList[Component]
 	state                 EntityState
@@ -125,7 +126,6 @@ type EntityBehavior struct {
 	enteredVersion        int64
 	managedHandles        event.ManagedHandles
 	managedRuntimeHandles [2]event.Handle
-	stringerCache         atomic.Pointer[string]
 
 	entityEventTab                 entityEventTab
 	entityComponentManagerEventTab entityComponentManagerEventTab
@@ -134,15 +134,12 @@ type EntityBehavior struct {
 
 // ID 返回实体 ID。
 func (entity *EntityBehavior) ID() uid.ID {
-	return entity.options.PersistID
+	return entity.getIncarnation().ID()
 }
 
 // PT 返回实体原型；尚未绑定原型时返回空原型对象。
 func (entity *EntityBehavior) PT() EntityPT {
-	if entity.prototype == nil {
-		return noneEntityPT
-	}
-	return entity.prototype
+	return entity.getIncarnation().PT()
 }
 
 // Scope 返回实体的可查询范围。
@@ -166,7 +163,7 @@ func (entity *EntityBehavior) Reflected() reflect.Value {
 
 // Meta 返回实体元数据。
 func (entity *EntityBehavior) Meta() meta.Meta {
-	return entity.options.Meta
+	return entity.getIncarnation().Meta()
 }
 
 // Managed 返回随实体销毁自动解绑的事件句柄集合。
@@ -191,7 +188,7 @@ func (entity *EntityBehavior) EventEntityDestroy() event.IEvent {
 
 // CurrentContextCache 返回实体所属 Runtime 的当前上下文接口缓存。
 func (entity *EntityBehavior) CurrentContextCache() iface.Cache {
-	return entity.runtimeCtx.CurrentContextCache()
+	return entity.getIncarnation().runtimeCtx.CurrentContextCache()
 }
 
 // InstanceFaceCache 返回实际实体实例的接口缓存，供类型重解释使用。
@@ -205,6 +202,8 @@ func (entity *EntityBehavior) init(options EntityOptions) {
 	if entity.options.InstanceFace.IsNil() {
 		entity.options.InstanceFace = iface.NewFaceT[Entity](entity)
 	}
+
+	entity.incarnation.Store(newEntityIncarnation(&entity.options))
 }
 
 func (entity *EntityBehavior) getOptions() *EntityOptions {
@@ -213,10 +212,11 @@ func (entity *EntityBehavior) getOptions() *EntityOptions {
 
 func (entity *EntityBehavior) setID(id uid.ID) {
 	entity.options.PersistID = id
+	entity.getIncarnation().id = id
 }
 
 func (entity *EntityBehavior) setPT(prototype EntityPT) {
-	entity.prototype = prototype
+	entity.getIncarnation().prototype.Store(&prototype)
 }
 
 func (entity *EntityBehavior) setState(state EntityState) {
@@ -228,14 +228,14 @@ func (entity *EntityBehavior) setState(state EntityState) {
 
 	switch entity.state {
 	case EntityState_Dead:
-		entity.asyncScope.Close()
+		entity.getIncarnation().asyncScope.Close()
 		entity.entityEventTab.SetEnabled(false)
 		entity.entityComponentManagerEventTab.SetEnabled(false)
 		entity.entityTreeNodeEventTab.SetEnabled(false)
 	case EntityState_Destroyed:
 		entity.managedHandles.UnbindAllEventHandles()
 		entity.managedUnbindRuntimeHandles()
-		entity.terminated.Complete()
+		entity.getIncarnation().terminated.Complete()
 	}
 }
 
@@ -273,3 +273,24 @@ func (entity *EntityBehavior) managedRuntimeLateUpdateHandle(lateUpdateHandle ev
 func (entity *EntityBehavior) managedUnbindRuntimeHandles() {
 	event.UnbindHandles(entity.managedRuntimeHandles[:])
 }
+
+func (entity *EntityBehavior) recycle(zeroInstance bool) {
+	options := entity.options
+	options.Meta = nil
+	state := entity.state
+
+	if zeroInstance {
+		zeroInstanceExcept(reflect.ValueOf(options.InstanceFace.Iface).Elem(), entity)
+	}
+
+	// incarnation 保持不变，旧引用仍然观察到实体已销毁；仅保留回收所需的选项与状态
+	entity._EntityBody = _EntityBody{
+		options: options,
+		state:   state,
+	}
+}
+
+func (entity *EntityBehavior) renew(options EntityOptions) {
+	entity._EntityBody = _EntityBody{}
+	entity.init(options)
+}
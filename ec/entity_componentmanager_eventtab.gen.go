@@ -23,6 +23,7 @@ package ec
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IEntityComponentManagerEventTab interface {
@@ -42,9 +43,9 @@ var (
 
 type entityComponentManagerEventTab [4]event.Event
 
-func (eventTab *entityComponentManagerEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *entityComponentManagerEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
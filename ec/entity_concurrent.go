@@ -22,10 +22,13 @@ package ec
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"git.golaxy.org/core/utils/async"
 	"git.golaxy.org/core/utils/corectx"
 	"git.golaxy.org/core/utils/iface"
+	"git.golaxy.org/core/utils/meta"
 	"git.golaxy.org/core/utils/uid"
 )
 
@@ -34,6 +37,9 @@ import (
 // 实体成功加入 Runtime 后，才能跨 goroutine 使用此接口；提前调用依赖 Runtime
 // Context 的方法属于未定义行为。AsyncScope 和 String 在 Context 绑定前返回空值。
 //
+// 启用实体池的实体在销毁后会以同一实例复用，跨 goroutine 长期持有实体时应使用 ConcurrentRef
+// 取得绑定本次生命周期的引用；服务实体管理器返回的实体均为此类引用。
+//
 // 组件管理、实体树和 Destroy 等操作仍须通过所属 Runtime 的运行协程执行。
 type ConcurrentEntity interface {
 	iConcurrentEntity
@@ -46,6 +52,10 @@ type ConcurrentEntity interface {
 	ID() uid.ID
 	// PT 返回实体原型。
 	PT() EntityPT
+	// Meta 返回实体元数据；元数据在实体构造时确定，之后只读。
+	Meta() meta.Meta
+	// Logger 返回在所属 Runtime 日志记录器上附带 entity_id 与 prototype 属性的日志记录器。
+	Logger() *slog.Logger
 }
 
 // iEntityContext 将实体生命周期作为 context.Context 暴露，并提供最终销毁完成通知。
@@ -63,62 +73,87 @@ type runtimeContext interface {
 	context.Context
 	corectx.CurrentContextProvider
 	async.WaitGuard
+
+	Logger() *slog.Logger
 }
 
 type iConcurrentEntity interface {
 	getInstance() Entity
+	getIncarnation() *_EntityIncarnation
 	setContext(rtCtx runtimeContext)
 }
 
+// Deadline 返回实体 Context 的截止时间。
+func (entity *EntityBehavior) Deadline() (time.Time, bool) {
+	return entity.getIncarnation().Deadline()
+}
+
+// Done 返回实体进入 Dead 时关闭的通道。
+func (entity *EntityBehavior) Done() <-chan struct{} {
+	return entity.getIncarnation().Done()
+}
+
+// Err 返回实体 Context 的结束原因。
+func (entity *EntityBehavior) Err() error {
+	return entity.getIncarnation().Err()
+}
+
+// Value 查询实体 Context 中的值。
+func (entity *EntityBehavior) Value(key any) any {
+	return entity.getIncarnation().Value(key)
+}
+
 // ConcurrentContextCache 返回实体所属 Runtime 的并发上下文接口缓存。
 func (entity *EntityBehavior) ConcurrentContextCache() iface.Cache {
-	return entity.runtimeCtx.ConcurrentContextCache()
+	return entity.getIncarnation().ConcurrentContextCache()
 }
 
 // AsyncScope 返回绑定实体生命周期的后台任务作用域；Runtime Context 尚未绑定时返回 nil。
 func (entity *EntityBehavior) AsyncScope() *async.Scope {
-	return entity.asyncScope
+	return entity.getIncarnation().AsyncScope()
 }
 
 // Terminated 返回实体进入 Destroyed 状态时兑现的 Signal。
 func (entity *EntityBehavior) Terminated() async.Signal {
-	return entity.terminated.Signal()
+	return entity.getIncarnation().Terminated()
 }
 
 // BeforeFutureWait 把 Entity 作为等待 Context 时的检查转交给所属 Runtime。
 func (entity *EntityBehavior) BeforeFutureWait(futureID async.FutureID, completionExecutorID async.ExecutorID) error {
-	return entity.runtimeCtx.BeforeFutureWait(futureID, completionExecutorID)
+	return entity.getIncarnation().BeforeFutureWait(futureID, completionExecutorID)
 }
 
 // AfterFutureWait 清理所属 Runtime 的等待诊断状态。
 func (entity *EntityBehavior) AfterFutureWait(futureID async.FutureID) {
-	entity.runtimeCtx.AfterFutureWait(futureID)
+	entity.getIncarnation().AfterFutureWait(futureID)
 }
 
 // String 返回包含实体 ID 与原型名的 JSON 文本；Runtime Context 尚未绑定时返回空字符串。
 func (entity *EntityBehavior) String() string {
-	if cached := entity.stringerCache.Load(); cached != nil {
-		return *cached
-	}
-
-	if entity.asyncScope == nil {
-		return ""
-	}
+	return entity.getIncarnation().String()
+}
 
-	value := fmt.Sprintf(`{"id":%q,"prototype":%q}`, entity.ID(), entity.PT().Prototype())
-	if entity.stringerCache.CompareAndSwap(nil, &value) {
-		return value
-	}
-	return *entity.stringerCache.Load()
+// Logger 返回在所属 Runtime 日志记录器上附带 entity_id 与 prototype 属性的日志记录器；
+// Runtime Context 尚未绑定时返回丢弃全部输出的日志记录器。
+func (entity *EntityBehavior) Logger() *slog.Logger {
+	return entity.getIncarnation().Logger()
 }
 
 func (entity *EntityBehavior) getInstance() Entity {
 	return entity.options.InstanceFace.Iface
 }
 
+func (entity *EntityBehavior) getIncarnation() *_EntityIncarnation {
+	if inc := entity.incarnation.Load(); inc != nil {
+		return inc
+	}
+	return noneEntityIncarnation
+}
+
 func (entity *EntityBehavior) setContext(rtCtx runtimeContext) {
-	entity.asyncScope = async.NewScope(rtCtx)
-	entity.Context = entity.asyncScope.Context()
-	entity.runtimeCtx = rtCtx
-	entity.terminated, _ = async.NewSignal()
+	inc := entity.getIncarnation()
+	inc.asyncScope = async.NewScope(rtCtx)
+	inc.ctx = inc.asyncScope.Context()
+	inc.runtimeCtx = rtCtx
+	inc.terminated, _ = async.NewSignal()
 }
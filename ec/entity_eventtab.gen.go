@@ -23,6 +23,7 @@ package ec
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IEntityEventTab interface {
@@ -36,9 +37,9 @@ var (
 
 type entityEventTab [1]event.Event
 
-func (eventTab *entityEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *entityEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
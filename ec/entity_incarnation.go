@@ -0,0 +1,221 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package ec
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/iface"
+	"git.golaxy.org/core/utils/meta"
+	"git.golaxy.org/core/utils/uid"
+)
+
+var entityIncarnationSeq atomic.Uint64
+
+// _EntityIncarnation 保存实体一次构造到销毁之间可跨协程读取的身份与上下文。
+// 实体从实体池复用时分配新的 incarnation，旧 incarnation 不再被修改，持有它的旧引用始终观察到已销毁的旧实体。
+type _EntityIncarnation struct {
+	seq           uint64
+	id            uid.ID
+	meta          meta.Meta
+	prototype     atomic.Pointer[EntityPT]
+	ctx           context.Context
+	asyncScope    *async.Scope
+	terminated    async.Completer
+	runtimeCtx    runtimeContext
+	stringerCache atomic.Pointer[string]
+	loggerCache   atomic.Pointer[slog.Logger]
+}
+
+var noneEntityIncarnation = &_EntityIncarnation{}
+
+func newEntityIncarnation(options *EntityOptions) *_EntityIncarnation {
+	return &_EntityIncarnation{
+		seq:  entityIncarnationSeq.Add(1),
+		id:   options.PersistID,
+		meta: options.Meta,
+	}
+}
+
+// Deadline 返回实体 Context 的截止时间。
+func (inc *_EntityIncarnation) Deadline() (time.Time, bool) {
+	return inc.ctx.Deadline()
+}
+
+// Done 返回实体进入 Dead 时关闭的通道。
+func (inc *_EntityIncarnation) Done() <-chan struct{} {
+	return inc.ctx.Done()
+}
+
+// Err 返回实体 Context 的结束原因。
+func (inc *_EntityIncarnation) Err() error {
+	return inc.ctx.Err()
+}
+
+// Value 查询实体 Context 中的值。
+func (inc *_EntityIncarnation) Value(key any) any {
+	return inc.ctx.Value(key)
+}
+
+// ID 返回实体 ID。
+func (inc *_EntityIncarnation) ID() uid.ID {
+	return inc.id
+}
+
+// PT 返回实体原型；尚未绑定原型时返回空原型对象。
+func (inc *_EntityIncarnation) PT() EntityPT {
+	prototype := inc.prototype.Load()
+	if prototype == nil {
+		return noneEntityPT
+	}
+	return *prototype
+}
+
+// Meta 返回实体元数据。
+func (inc *_EntityIncarnation) Meta() meta.Meta {
+	return inc.meta
+}
+
+// ConcurrentContextCache 返回实体所属 Runtime 的并发上下文接口缓存。
+func (inc *_EntityIncarnation) ConcurrentContextCache() iface.Cache {
+	return inc.runtimeCtx.ConcurrentContextCache()
+}
+
+// AsyncScope 返回绑定实体生命周期的后台任务作用域；Runtime Context 尚未绑定时返回 nil。
+func (inc *_EntityIncarnation) AsyncScope() *async.Scope {
+	return inc.asyncScope
+}
+
+// Terminated 返回实体进入 Destroyed 状态时兑现的 Signal。
+func (inc *_EntityIncarnation) Terminated() async.Signal {
+	return inc.terminated.Signal()
+}
+
+// BeforeFutureWait 把实体作为等待 Context 时的检查转交给所属 Runtime。
+func (inc *_EntityIncarnation) BeforeFutureWait(futureID async.FutureID, completionExecutorID async.ExecutorID) error {
+	return inc.runtimeCtx.BeforeFutureWait(futureID, completionExecutorID)
+}
+
+// AfterFutureWait 清理所属 Runtime 的等待诊断状态。
+func (inc *_EntityIncarnation) AfterFutureWait(futureID async.FutureID) {
+	inc.runtimeCtx.AfterFutureWait(futureID)
+}
+
+// String 返回包含实体 ID 与原型名的 JSON 文本；Runtime Context 尚未绑定时返回空字符串。
+func (inc *_EntityIncarnation) String() string {
+	if cached := inc.stringerCache.Load(); cached != nil {
+		return *cached
+	}
+
+	if inc.asyncScope == nil {
+		return ""
+	}
+
+	value := fmt.Sprintf(`{"id":%q,"prototype":%q}`, inc.ID(), inc.PT().Prototype())
+	if inc.stringerCache.CompareAndSwap(nil, &value) {
+		return value
+	}
+	return *inc.stringerCache.Load()
+}
+
+// Logger 返回在所属 Runtime 日志记录器上附带 entity_id 与 prototype 属性的日志记录器；
+// Runtime Context 尚未绑定时返回丢弃全部输出的日志记录器。
+func (inc *_EntityIncarnation) Logger() *slog.Logger {
+	if cached := inc.loggerCache.Load(); cached != nil {
+		return cached
+	}
+
+	if inc.runtimeCtx == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+
+	logger := inc.runtimeCtx.Logger().With("entity_id", inc.ID(), "prototype", inc.PT().Prototype())
+	if inc.loggerCache.CompareAndSwap(nil, logger) {
+		return logger
+	}
+	return inc.loggerCache.Load()
+}
+
+// ConcurrentRef 返回绑定实体当前生命周期的 ConcurrentEntity 引用。
+//
+// 启用实体池的实体销毁后会以同一实例复用，直接持有的实体实例在复用后指向新的实体；
+// 跨 goroutine 长期持有实体时应使用 ConcurrentRef。引用只读取本次生命周期的身份与上下文，
+// 实体被复用后，经引用投递的调用会因实体已回收而失败，不会作用到新的实体上。
+func ConcurrentRef(entity ConcurrentEntity) ConcurrentEntity {
+	if entity == nil {
+		return nil
+	}
+	if ref, ok := entity.(_ConcurrentEntityRef); ok {
+		return ref
+	}
+	return _ConcurrentEntityRef{
+		_EntityIncarnation: entity.getIncarnation(),
+		instance:           entity.getInstance(),
+	}
+}
+
+type _ConcurrentEntityRef struct {
+	*_EntityIncarnation
+	instance Entity
+}
+
+func (ref _ConcurrentEntityRef) getInstance() Entity {
+	return ref.instance
+}
+
+func (ref _ConcurrentEntityRef) getIncarnation() *_EntityIncarnation {
+	return ref._EntityIncarnation
+}
+
+func (ref _ConcurrentEntityRef) setContext(rtCtx runtimeContext) {
+	exception.Panicf("%w: can not set the context of a concurrent entity reference", ErrEC)
+}
+
+// zeroInstanceExcept 清零 v 中除 keep 指向的字段以外的全部字段，
+// 使持有旧引用的 goroutine 读取 keep 时不与清零产生竞争。
+func zeroInstanceExcept[T any](v reflect.Value, keep *T) {
+	keepAddr := uintptr(unsafe.Pointer(keep))
+	keepEnd := keepAddr + unsafe.Sizeof(*keep)
+
+	if v.Addr().Pointer() == keepAddr && v.Type() == reflect.TypeFor[T]() {
+		return
+	}
+
+	for i := range v.NumField() {
+		field := v.Field(i)
+		fieldAddr := field.Addr().Pointer()
+		fieldEnd := fieldAddr + field.Type().Size()
+
+		switch {
+		case fieldEnd <= keepAddr || fieldAddr >= keepEnd:
+			reflect.NewAt(field.Type(), field.Addr().UnsafePointer()).Elem().SetZero()
+		case field.Kind() == reflect.Struct:
+			zeroInstanceExcept(field, keep)
+		}
+	}
+}
@@ -23,6 +23,7 @@ package ec
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IEntityTreeNodeEventTab interface {
@@ -44,9 +45,9 @@ var (
 
 type entityTreeNodeEventTab [5]event.Event
 
-func (eventTab *entityTreeNodeEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *entityTreeNodeEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
@@ -0,0 +1,126 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package ec
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ComponentMessageHandlers 由组件指针类型实现，显式声明组件原型的消息处理方法；未实现时组件不处理任何消息。
+// 处理方法须为组件指针类型上只接收一个非接口类型参数的导出方法，返回值可以为空、(error)、(R) 或 (R, error)。
+type ComponentMessageHandlers interface {
+	// MessageHandlerMethods 返回消息处理方法名；仅在声明组件原型时以零值实例调用一次。
+	MessageHandlerMethods() []string
+}
+
+// MessageHandler 描述组件原型中处理一种消息类型的方法。
+type MessageHandler struct {
+	MessageRT  reflect.Type   // MessageRT 是消息类型。
+	ResponseRT reflect.Type   // ResponseRT 是响应类型；处理方法没有响应值时为 nil。
+	Method     reflect.Method // Method 是组件实例指针类型上的处理方法。
+}
+
+// EntityMessageHandler 描述实体原型中处理一种消息类型的内建组件方法。
+type EntityMessageHandler struct {
+	Component BuiltinComponent // Component 是登记该处理器的内建组件。
+	Handler   MessageHandler   // Handler 是处理方法。
+}
+
+// Invoke 以 comp 为接收者调用处理方法，返回响应值与处理方法返回的错误；comp 类型不符时 panic。
+func (h MessageHandler) Invoke(comp Component, msg any) (any, error) {
+	outs := h.Method.Func.Call([]reflect.Value{comp.Reflected(), reflect.ValueOf(msg)})
+
+	var resp any
+	var err error
+
+	for _, out := range outs {
+		if out.Type() == errorRT {
+			err, _ = out.Interface().(error)
+			continue
+		}
+		resp = out.Interface()
+	}
+
+	return resp, err
+}
+
+var errorRT = reflect.TypeFor[error]()
+
+// LookupMessageHandlers 返回组件指针类型 compRT 按 ComponentMessageHandlers 声明的消息处理方法，按声明顺序排列。
+// 声明的方法不存在、签名不符或同一消息类型存在多个处理方法时返回错误。
+func LookupMessageHandlers(compRT reflect.Type) ([]MessageHandler, error) {
+	if !compRT.Implements(reflect.TypeFor[ComponentMessageHandlers]()) {
+		return nil, nil
+	}
+
+	var handlers []MessageHandler
+	owners := map[reflect.Type]string{}
+
+	for _, name := range reflect.New(compRT.Elem()).Interface().(ComponentMessageHandlers).MessageHandlerMethods() {
+		method, ok := compRT.MethodByName(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: message handler %q not found", ErrEC, name)
+		}
+
+		handler, ok := parseMessageHandler(method)
+		if !ok {
+			return nil, fmt.Errorf("%w: message handler %q has an invalid signature %q", ErrEC, name, method.Type)
+		}
+
+		if owner, ok := owners[handler.MessageRT]; ok {
+			return nil, fmt.Errorf("%w: message handlers %q and %q handle the same message type %q", ErrEC, owner, method.Name, handler.MessageRT)
+		}
+		owners[handler.MessageRT] = method.Name
+
+		handlers = append(handlers, handler)
+	}
+
+	return handlers, nil
+}
+
+func parseMessageHandler(method reflect.Method) (MessageHandler, bool) {
+	methodRT := method.Type
+	if methodRT.NumIn() != 2 || methodRT.IsVariadic() || methodRT.In(1).Kind() == reflect.Interface {
+		return MessageHandler{}, false
+	}
+
+	handler := MessageHandler{
+		MessageRT: methodRT.In(1),
+		Method:    method,
+	}
+
+	switch methodRT.NumOut() {
+	case 0:
+	case 1:
+		if methodRT.Out(0) != errorRT {
+			handler.ResponseRT = methodRT.Out(0)
+		}
+	case 2:
+		if methodRT.Out(1) != errorRT {
+			return MessageHandler{}, false
+		}
+		handler.ResponseRT = methodRT.Out(0)
+	default:
+		return MessageHandler{}, false
+	}
+
+	return handler, true
+}
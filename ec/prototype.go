@@ -35,6 +35,8 @@ type EntityPT interface {
 
 	// Prototype 返回实体原型名。
 	Prototype() string
+	// Version 返回原型版本号；首次声明为 1，同名原型每次重新声明递增。
+	Version() int64
 	// InstanceRT 返回实际实体实例的指针类型；使用默认实体实现时返回 nil。
 	InstanceRT() reflect.Type
 	// Scope 返回原型的默认实体作用域。
@@ -43,6 +45,10 @@ type EntityPT interface {
 	ComponentAwakeOnFirstTouch() bool
 	// ComponentUniqueID 报告是否为组件分配唯一 ID。
 	ComponentUniqueID() bool
+	// PoolSize 返回每个运行时缓存的已回收实体上限；为 0 时不启用实体池。
+	PoolSize() int
+	// IndexedMeta 返回全局实体注册时建立二级索引的元数据键的副本。
+	IndexedMeta() []string
 	// Meta 返回原型元数据。
 	Meta() meta.Meta
 	// CountComponents 返回内建组件数。
@@ -53,6 +59,13 @@ type EntityPT interface {
 	ListComponents() []BuiltinComponent
 	// Construct 根据原型创建处于 Born 状态的实体，并应用额外选项。
 	Construct(settings ...option.Setting[EntityOptions]) Entity
+	// ConstructComponent 根据指定位置的内建组件描述创建处于 Born 状态的组件；索引越界时 panic。
+	ConstructComponent(idx int) Component
+	// MessageHandler 按消息类型查询内建组件登记的消息处理器。
+	MessageHandler(messageRT reflect.Type) (EntityMessageHandler, bool)
+	// Reconstruct 复用已回收的实体与组件实例，重新装配处于 Born 状态的实体，并应用额外选项。
+	// components 按内建组件位置对应，缺失或类型不符的位置会重新构造组件。
+	Reconstruct(entity Entity, components []Component, settings ...option.Setting[EntityOptions]) Entity
 }
 
 // BuiltinComponent 描述实体原型中的一个内建组件。
@@ -107,6 +120,12 @@ type ComponentPT interface {
 	Prototype() string
 	// InstanceRT 返回实际组件实例的指针类型。
 	InstanceRT() reflect.Type
+	// MessageHandlers 返回组件声明时按 ComponentMessageHandlers 登记的消息处理器。
+	MessageHandlers() []MessageHandler
+	// UpdateOrder 返回组件声明时按 ComponentUpdateOrder 读取的 Update 与 LateUpdate 执行顺序值。
+	UpdateOrder() int32
+	// UpdateInterval 返回组件声明时按 ComponentUpdateInterval 读取的 Update 与 LateUpdate 节流间隔。
+	UpdateInterval() UpdateInterval
 	// Construct 根据原型创建处于 Born 状态的组件。
 	Construct() Component
 }
@@ -124,6 +143,11 @@ func (_NoneEntityPT) Prototype() string {
 	return ""
 }
 
+// Version 对空实体原型返回 0。
+func (_NoneEntityPT) Version() int64 {
+	return 0
+}
+
 // InstanceRT 返回 nil，表示空实体原型没有实例类型。
 func (_NoneEntityPT) InstanceRT() reflect.Type {
 	return nil
@@ -144,11 +168,26 @@ func (_NoneEntityPT) ComponentUniqueID() bool {
 	return false
 }
 
+// PoolSize 对空实体原型返回 0。
+func (_NoneEntityPT) PoolSize() int {
+	return 0
+}
+
+// IndexedMeta 对空实体原型返回 nil。
+func (_NoneEntityPT) IndexedMeta() []string {
+	return nil
+}
+
 // Meta 对空实体原型返回 nil。
 func (_NoneEntityPT) Meta() meta.Meta {
 	return nil
 }
 
+// MessageHandler 对空实体原型返回 false。
+func (_NoneEntityPT) MessageHandler(messageRT reflect.Type) (EntityMessageHandler, bool) {
+	return EntityMessageHandler{}, false
+}
+
 // CountComponents 对空实体原型返回 0。
 func (_NoneEntityPT) CountComponents() int {
 	return 0
@@ -171,6 +210,18 @@ func (_NoneEntityPT) Construct(settings ...option.Setting[EntityOptions]) Entity
 	panic("unreachable")
 }
 
+// ConstructComponent 对空实体原型始终 panic。
+func (_NoneEntityPT) ConstructComponent(idx int) Component {
+	exception.Panicf("%w: %w: idx out of range", ErrEC, exception.ErrArgs)
+	panic("unreachable")
+}
+
+// Reconstruct 对空实体原型始终 panic。
+func (_NoneEntityPT) Reconstruct(entity Entity, components []Component, settings ...option.Setting[EntityOptions]) Entity {
+	exception.Panicf("%w: %w: none prototype", ErrEC, exception.ErrArgs)
+	panic("unreachable")
+}
+
 // String 返回 JSON 空值文本。
 func (_NoneEntityPT) String() string {
 	return "null"
@@ -193,6 +244,21 @@ func (_NoneComponentPT) InstanceRT() reflect.Type {
 	return nil
 }
 
+// MessageHandlers 对空组件原型返回 nil。
+func (_NoneComponentPT) MessageHandlers() []MessageHandler {
+	return nil
+}
+
+// UpdateOrder 对空组件原型返回 UpdateGroup_Default。
+func (_NoneComponentPT) UpdateOrder() int32 {
+	return UpdateGroup_Default
+}
+
+// UpdateInterval 对空组件原型返回零值，表示每帧更新。
+func (_NoneComponentPT) UpdateInterval() UpdateInterval {
+	return UpdateInterval{}
+}
+
 // Construct 对空组件原型始终 panic。
 func (_NoneComponentPT) Construct() Component {
 	exception.Panicf("%w: %w: none prototype", ErrEC, exception.ErrArgs)
@@ -22,6 +22,7 @@ package pt
 import (
 	"encoding/json"
 	"reflect"
+	"slices"
 	"sync/atomic"
 
 	"git.golaxy.org/core/ec"
@@ -29,10 +30,13 @@ import (
 )
 
 type _Component struct {
-	prototype     string
-	instanceRT    reflect.Type
-	builtin       *ec.BuiltinComponent
-	stringerCache atomic.Pointer[string]
+	prototype       string
+	instanceRT      reflect.Type
+	messageHandlers []ec.MessageHandler
+	updateOrder     int32
+	updateInterval  ec.UpdateInterval
+	builtin         *ec.BuiltinComponent
+	stringerCache   atomic.Pointer[string]
 }
 
 // Prototype 返回组件的完整原型名。
@@ -45,6 +49,21 @@ func (pt *_Component) InstanceRT() reflect.Type {
 	return reflect.PointerTo(pt.instanceRT)
 }
 
+// MessageHandlers 返回组件声明时按 ec.ComponentMessageHandlers 登记的消息处理器。
+func (pt *_Component) MessageHandlers() []ec.MessageHandler {
+	return slices.Clone(pt.messageHandlers)
+}
+
+// UpdateOrder 返回组件声明时按 ec.ComponentUpdateOrder 读取的 Update 与 LateUpdate 执行顺序值。
+func (pt *_Component) UpdateOrder() int32 {
+	return pt.updateOrder
+}
+
+// UpdateInterval 返回组件声明时按 ec.ComponentUpdateInterval 读取的 Update 与 LateUpdate 节流间隔。
+func (pt *_Component) UpdateInterval() ec.UpdateInterval {
+	return pt.updateInterval
+}
+
 // Construct 创建处于 Born 状态的组件，并绑定其组件原型。
 func (pt *_Component) Construct() ec.Component {
 	compRV := reflect.New(pt.instanceRT)
@@ -0,0 +1,275 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package pt
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/types"
+)
+
+// MetaTag 是组件字段绑定元数据时使用的结构体标签名，例如 `meta:"speed"`；值为 "-" 时忽略该字段。
+const MetaTag = "meta"
+
+// BindComponentMeta 将元数据写入 comp 中带 MetaTag 标签的导出字段。
+//
+// 同名键按优先级从高到低依次取自实体元数据（EntityCreator 设置的元数据）、组件在实体原型中
+// 的内建描述元数据以及实体原型元数据；均不存在时字段保持原值。值可直接赋值时原样写入，
+// 数值类型之间在不丢失精度时转换，字符串可写入 time.Duration 与实现 encoding.TextUnmarshaler
+// 的字段，其余情况按 JSON 规则转换。转换失败时返回错误，已写入的字段不会回滚。
+// 应在组件执行 Awake 前调用，通常在组件加入受管实体之前。
+func BindComponentMeta(entity ec.Entity, comp ec.Component) error {
+	if entity == nil {
+		exception.Panicf("%w: %w: entity is nil", ErrPt, exception.ErrArgs)
+	}
+	if comp == nil {
+		exception.Panicf("%w: %w: comp is nil", ErrPt, exception.ErrArgs)
+	}
+
+	compRV := comp.Reflected()
+	for compRV.Kind() == reflect.Pointer || compRV.Kind() == reflect.Interface {
+		if compRV.IsNil() {
+			return nil
+		}
+		compRV = compRV.Elem()
+	}
+	if compRV.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := lookupMetaFields(compRV.Type())
+	if len(fields) <= 0 {
+		return nil
+	}
+
+	entityMeta := entity.Meta()
+	builtinMeta := comp.Builtin().Meta
+	entityPTMeta := entity.PT().Meta()
+
+	for _, field := range fields {
+		v, ok := entityMeta.Get(field.key)
+		if !ok {
+			v, ok = builtinMeta.Get(field.key)
+		}
+		if !ok {
+			v, ok = entityPTMeta.Get(field.key)
+		}
+		if !ok {
+			continue
+		}
+
+		if err := assignMetaValue(compRV.FieldByIndex(field.index), v); err != nil {
+			name := comp.Name()
+			if name == "" {
+				name = comp.Builtin().Name
+			}
+			return fmt.Errorf("%w: component %q field %q bind meta %q failed, %w", ErrPt, name, field.name, field.key, err)
+		}
+	}
+
+	return nil
+}
+
+type _MetaField struct {
+	index []int
+	name  string
+	key   string
+}
+
+var metaFieldsCache sync.Map
+
+func lookupMetaFields(rt reflect.Type) []_MetaField {
+	if cached, ok := metaFieldsCache.Load(rt); ok {
+		return cached.([]_MetaField)
+	}
+
+	var fields []_MetaField
+	collectMetaFields(rt, nil, &fields)
+
+	cached, _ := metaFieldsCache.LoadOrStore(rt, fields)
+	return cached.([]_MetaField)
+}
+
+func collectMetaFields(rt reflect.Type, index []int, fields *[]_MetaField) {
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+
+		tag, tagged := field.Tag.Lookup(MetaTag)
+		if tag == "-" {
+			continue
+		}
+
+		if field.Anonymous && !tagged {
+			if field.Type.Kind() == reflect.Struct {
+				collectMetaFields(field.Type, append(index[:len(index):len(index)], i), fields)
+			}
+			continue
+		}
+
+		if !tagged || !field.IsExported() {
+			continue
+		}
+
+		key, _, _ := strings.Cut(tag, ",")
+		if key == "" {
+			key = field.Name
+		}
+
+		*fields = append(*fields, _MetaField{
+			index: append(index[:len(index):len(index)], i),
+			name:  field.Name,
+			key:   key,
+		})
+	}
+}
+
+var (
+	durationRT        = reflect.TypeFor[time.Duration]()
+	textUnmarshalerRT = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+func assignMetaValue(dst reflect.Value, v any) error {
+	if v == nil {
+		dst.SetZero()
+		return nil
+	}
+
+	src := reflect.ValueOf(v)
+
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	if src.Kind() == reflect.String {
+		if dst.Type() == durationRT {
+			dur, err := time.ParseDuration(src.String())
+			if err != nil {
+				return err
+			}
+			dst.SetInt(int64(dur))
+			return nil
+		}
+		if reflect.PointerTo(dst.Type()).Implements(textUnmarshalerRT) {
+			return dst.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(src.String()))
+		}
+	}
+
+	if isNumberKind(src.Kind()) && isNumberKind(dst.Kind()) {
+		return assignMetaNumber(dst, src)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	ptr := reflect.New(dst.Type())
+	if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+		return fmt.Errorf("can't convert %s to %s", types.FullNameRT(src.Type()), types.FullNameRT(dst.Type()))
+	}
+	dst.Set(ptr.Elem())
+	return nil
+}
+
+func assignMetaNumber(dst, src reflect.Value) error {
+	overflow := func() error {
+		return fmt.Errorf("value %v overflows %s", src.Interface(), types.FullNameRT(dst.Type()))
+	}
+
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch {
+		case src.CanInt():
+			n = src.Int()
+		case src.CanUint():
+			if src.Uint() > math.MaxInt64 {
+				return overflow()
+			}
+			n = int64(src.Uint())
+		default:
+			f := src.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return overflow()
+			}
+			n = int64(f)
+		}
+		if dst.OverflowInt(n) {
+			return overflow()
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		switch {
+		case src.CanInt():
+			if src.Int() < 0 {
+				return overflow()
+			}
+			n = uint64(src.Int())
+		case src.CanUint():
+			n = src.Uint()
+		default:
+			f := src.Float()
+			if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+				return overflow()
+			}
+			n = uint64(f)
+		}
+		if dst.OverflowUint(n) {
+			return overflow()
+		}
+		dst.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch {
+		case src.CanInt():
+			f = float64(src.Int())
+		case src.CanUint():
+			f = float64(src.Uint())
+		default:
+			f = src.Float()
+		}
+		if dst.OverflowFloat(f) {
+			return overflow()
+		}
+		dst.SetFloat(f)
+	}
+
+	return nil
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
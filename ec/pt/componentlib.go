@@ -116,9 +116,17 @@ func (lib *_ComponentLib) Declare(comp any) ec.ComponentPT {
 		return compPT
 	}
 
+	messageHandlers, err := ec.LookupMessageHandlers(reflect.PointerTo(compRT))
+	if err != nil {
+		exception.Panicf("%w: component %q: %w", ErrPt, prototype, err)
+	}
+
 	compPT := &_Component{
-		prototype:  prototype,
-		instanceRT: compRT,
+		prototype:       prototype,
+		instanceRT:      compRT,
+		messageHandlers: messageHandlers,
+		updateOrder:     ec.LookupUpdateOrder(reflect.PointerTo(compRT)),
+		updateInterval:  ec.LookupUpdateInterval(reflect.PointerTo(compRT)),
 	}
 	compPT.builtin = &ec.BuiltinComponent{PT: compPT, Offset: -1}
 
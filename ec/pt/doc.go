@@ -28,7 +28,9 @@ Package pt 用于声明、查询和构造实体原型与组件原型。
   - EntityPT / ComponentPT：供 ec 与 service/runtime 使用的原型对象。
 
 服务启动阶段通常会通过 service.Context.EntityLib() 或根包的 BuildEntityPT 声明
-实体原型；运行时创建实体时，再根据原型生成实体与内建组件。
+实体原型；运行时创建实体时，再根据原型生成实体与内建组件。也可以通过 Loader 从
+JSON 或 YAML 文档加载实体原型，文档中的组件按名称引用 ComponentLib 中已声明的
+组件原型，错误会携带文档行列号。
 
 EntityLib 与 ComponentLib 均可并发使用。组件原型的重复声明会返回已有对象；实体
 原型的同名声明则会替换旧对象并向观察者发布新版本。
@@ -34,12 +34,17 @@ import (
 
 type _Entity struct {
 	prototype                  string
+	version                    int64
 	instanceRT                 reflect.Type
 	scope                      ec.Scope
 	componentAwakeOnFirstTouch bool
 	componentUniqueID          bool
+	poolSize                   int
+	indexedMeta                []string
 	meta                       meta.Meta
 	components                 []ec.BuiltinComponent
+	messageHandlers            map[reflect.Type]ec.EntityMessageHandler
+	componentFields            [][]byte
 	stringerCache              atomic.Pointer[string]
 }
 
@@ -48,6 +53,11 @@ func (pt *_Entity) Prototype() string {
 	return pt.prototype
 }
 
+// Version 返回原型版本号；首次声明为 1，同名原型每次重新声明递增。
+func (pt *_Entity) Version() int64 {
+	return pt.version
+}
+
 // InstanceRT 返回实体实例的指针类型；使用默认实体实现时返回 nil。
 func (pt *_Entity) InstanceRT() reflect.Type {
 	if pt.instanceRT == nil {
@@ -71,11 +81,27 @@ func (pt *_Entity) ComponentUniqueID() bool {
 	return pt.componentUniqueID
 }
 
+// PoolSize 返回每个运行时缓存的已回收实体上限；为 0 时不启用实体池。
+func (pt *_Entity) PoolSize() int {
+	return pt.poolSize
+}
+
+// IndexedMeta 返回全局实体注册时建立二级索引的元数据键的副本。
+func (pt *_Entity) IndexedMeta() []string {
+	return slices.Clone(pt.indexedMeta)
+}
+
 // Meta 返回实体原型元数据。
 func (pt *_Entity) Meta() meta.Meta {
 	return pt.meta
 }
 
+// MessageHandler 按消息类型查询内建组件登记的消息处理器。
+func (pt *_Entity) MessageHandler(messageRT reflect.Type) (ec.EntityMessageHandler, bool) {
+	handler, ok := pt.messageHandlers[messageRT]
+	return handler, ok
+}
+
 // CountComponents 返回内建组件数。
 func (pt *_Entity) CountComponents() int {
 	return len(pt.components)
@@ -96,16 +122,47 @@ func (pt *_Entity) ListComponents() []ec.BuiltinComponent {
 
 // Construct 根据原型创建处于 Born 状态的实体，并应用额外选项。
 func (pt *_Entity) Construct(settings ...option.Setting[ec.EntityOptions]) ec.Entity {
-	options := option.New(ec.With.Default())
+	options := pt.defaultOptions()
 	if pt.instanceRT != nil {
 		options.InstanceFace = iface.NewFaceT(reflect.New(pt.instanceRT).Interface().(ec.Entity))
 	}
-	options.Scope = pt.scope
-	options.ComponentAwakeOnFirstTouch = pt.componentAwakeOnFirstTouch
-	options.ComponentUniqueID = pt.componentUniqueID
 	options = option.Append(options, settings...)
 
-	return pt.assemble(ec.UnsafeNewEntity(options))
+	return pt.assemble(ec.UnsafeNewEntity(options), nil)
+}
+
+// ConstructComponent 根据指定位置的内建组件描述创建处于 Born 状态的组件，并写入声明的字段初值；
+// 索引越界时 panic。
+func (pt *_Entity) ConstructComponent(idx int) ec.Component {
+	if idx < 0 || idx >= len(pt.components) {
+		exception.Panicf("%w: %w: idx out of range", ErrPt, exception.ErrArgs)
+	}
+	return pt.initComponent(idx, pt.components[idx].PT.Construct())
+}
+
+// Reconstruct 复用已回收的实体与组件实例，重新装配处于 Born 状态的实体，并应用额外选项。
+// components 按内建组件位置对应，缺失或类型不符的位置会重新构造组件；settings 不应覆盖 InstanceFace。
+// entity 的实例类型与原型不符时 panic。
+func (pt *_Entity) Reconstruct(entity ec.Entity, components []ec.Component, settings ...option.Setting[ec.EntityOptions]) ec.Entity {
+	if entity == nil {
+		exception.Panicf("%w: %w: entity is nil", ErrPt, exception.ErrArgs)
+	}
+
+	instanceRT := pt.InstanceRT()
+	if instanceRT == nil {
+		instanceRT = reflect.TypeFor[*ec.EntityBehavior]()
+	}
+	if reflect.TypeOf(entity) != instanceRT {
+		exception.Panicf("%w: %w: entity instance %T does not match the prototype %q", ErrPt, exception.ErrArgs, entity, pt.prototype)
+	}
+
+	options := pt.defaultOptions()
+	options.InstanceFace = iface.NewFaceT(entity)
+	options = option.Append(options, settings...)
+
+	ec.UnsafeEntity(entity).Renew(options)
+
+	return pt.assemble(entity, components)
 }
 
 // String 返回实体原型的 JSON 文本；编码失败时 panic。
@@ -127,10 +184,13 @@ func (pt *_Entity) String() string {
 
 type _EntityJSON struct {
 	Prototype                  string                `json:"prototype"`
+	Version                    int64                 `json:"version"`
 	Instance                   string                `json:"instance"`
 	Scope                      string                `json:"scope"`
 	ComponentAwakeOnFirstTouch bool                  `json:"component_awake_on_first_touch"`
 	ComponentUniqueID          bool                  `json:"component_unique_id"`
+	PoolSize                   int                   `json:"pool_size"`
+	IndexedMeta                []string              `json:"indexed_meta"`
 	Meta                       map[string]any        `json:"meta"`
 	Components                 []ec.BuiltinComponent `json:"components"`
 }
@@ -139,9 +199,12 @@ type _EntityJSON struct {
 func (pt *_Entity) MarshalJSON() ([]byte, error) {
 	entityStringer := _EntityJSON{
 		Prototype:                  pt.prototype,
+		Version:                    pt.version,
 		Scope:                      pt.scope.String(),
 		ComponentAwakeOnFirstTouch: pt.componentAwakeOnFirstTouch,
 		ComponentUniqueID:          pt.componentUniqueID,
+		PoolSize:                   pt.poolSize,
+		IndexedMeta:                pt.indexedMeta,
 		Meta:                       pt.meta.ToGoMap(),
 		Components:                 pt.components,
 	}
@@ -157,7 +220,29 @@ func (pt *_Entity) MarshalJSON() ([]byte, error) {
 	return data, nil
 }
 
-func (pt *_Entity) assemble(entity ec.Entity) ec.Entity {
+func (pt *_Entity) defaultOptions() ec.EntityOptions {
+	options := option.New(ec.With.Default())
+	options.Scope = pt.scope
+	options.ComponentAwakeOnFirstTouch = pt.componentAwakeOnFirstTouch
+	options.ComponentUniqueID = pt.componentUniqueID
+	return options
+}
+
+func (pt *_Entity) initComponent(idx int, comp ec.Component) ec.Component {
+	builtin := &pt.components[idx]
+
+	ec.UnsafeComponent(comp).SetBuiltin(builtin)
+
+	if fields := pt.componentFields[idx]; fields != nil {
+		if err := json.Unmarshal(fields, comp); err != nil {
+			exception.Panicf("%w: builtin component %q fields: %w", ErrPt, builtin.Name, err)
+		}
+	}
+
+	return comp
+}
+
+func (pt *_Entity) assemble(entity ec.Entity, recycled []ec.Component) ec.Entity {
 	if entity == nil {
 		exception.Panicf("%w: %w: entity is nil", ErrPt, exception.ErrArgs)
 	}
@@ -167,8 +252,14 @@ func (pt *_Entity) assemble(entity ec.Entity) ec.Entity {
 	for i := range pt.components {
 		builtin := &pt.components[i]
 
-		comp := builtin.PT.Construct()
-		ec.UnsafeComponent(comp).SetBuiltin(builtin)
+		var comp ec.Component
+		if i < len(recycled) && recycled[i] != nil && reflect.TypeOf(recycled[i]) == builtin.PT.InstanceRT() {
+			comp = recycled[i]
+			ec.UnsafeComponent(comp).Renew()
+			pt.initComponent(i, comp)
+		} else {
+			comp = pt.ConstructComponent(i)
+		}
 
 		if err := entity.AddComponent(builtin.Name, comp); err != nil {
 			exception.Panicf("%w: %w", ErrPt, err)
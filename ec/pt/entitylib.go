@@ -39,7 +39,7 @@ type EntityLib interface {
 
 	// ComponentLib 返回实体原型解析组件时使用的组件原型库。
 	ComponentLib() ComponentLib
-	// Declare 声明实体原型；同名声明会替换旧原型。
+	// Declare 声明实体原型；同名声明会替换旧原型并递增原型版本号。
 	Declare(prototype any, comps ...any) ec.EntityPT
 	// Get 按原型名查询实体原型。
 	Get(prototype string) (ec.EntityPT, bool)
@@ -92,7 +92,8 @@ func (lib *_EntityLib) ComponentLib() ComponentLib {
 	return lib.compLib
 }
 
-// Declare 声明实体原型；同名声明会替换旧原型并发布一次声明事件。
+// Declare 声明实体原型；同名声明会替换旧原型、递增原型版本号并发布一次声明事件。
+// 已创建的实体仍使用旧原型，除非其所属运行时启用了实体原型升级。
 //
 // prototype 支持原型名、EntityDescriptor 或其指针；comps 支持组件值、完整原型名、
 // ComponentDescriptor 或其指针。参数无效或引用未声明的组件原型时 panic。
@@ -130,6 +131,8 @@ func (lib *_EntityLib) Declare(prototype any, comps ...any) ec.EntityPT {
 		scope:                      entityDescr.Scope,
 		componentAwakeOnFirstTouch: entityDescr.ComponentAwakeOnFirstTouch,
 		componentUniqueID:          entityDescr.ComponentUniqueID,
+		poolSize:                   max(entityDescr.PoolSize, 0),
+		indexedMeta:                compactIndexedMeta(entityDescr.IndexedMeta),
 		meta:                       entityDescr.Meta,
 	}
 
@@ -158,6 +161,7 @@ func (lib *_EntityLib) Declare(prototype any, comps ...any) ec.EntityPT {
 		builtin := ec.BuiltinComponent{
 			Offset: i,
 		}
+		var fields map[string]any
 
 	retry:
 		switch v := comp.(type) {
@@ -165,6 +169,7 @@ func (lib *_EntityLib) Declare(prototype any, comps ...any) ec.EntityPT {
 			builtin.Name = v.Name
 			builtin.Removable = v.Removable
 			builtin.Meta = v.Meta
+			fields = v.Fields
 			comp = v.Instance
 			goto retry
 		case *ComponentDescriptor:
@@ -187,13 +192,36 @@ func (lib *_EntityLib) Declare(prototype any, comps ...any) ec.EntityPT {
 			builtin.Name = types.NameRT(builtin.PT.InstanceRT().Elem())
 		}
 
+		var fieldsData []byte
+		if len(fields) > 0 {
+			data, err := encodeComponentFields(builtin.PT.InstanceRT().Elem(), fields)
+			if err != nil {
+				exception.Panicf("%w: entity %q builtin component %q fields: %w", ErrPt, prototype, builtin.Name, err)
+			}
+			fieldsData = data
+		}
+
+		for _, handler := range builtin.PT.MessageHandlers() {
+			if registered, ok := entityPT.messageHandlers[handler.MessageRT]; ok {
+				exception.Panicf("%w: entity %q builtin components %q and %q both handle message type %q", ErrPt, prototype, registered.Component.Name, builtin.Name, handler.MessageRT)
+			}
+			if entityPT.messageHandlers == nil {
+				entityPT.messageHandlers = map[reflect.Type]ec.EntityMessageHandler{}
+			}
+			entityPT.messageHandlers[handler.MessageRT] = ec.EntityMessageHandler{Component: builtin, Handler: handler}
+		}
+
 		entityPT.components = append(entityPT.components, builtin)
+		entityPT.componentFields = append(entityPT.componentFields, fieldsData)
 	}
 
 	snapshot := lib.snapshot.Load()
 	next := snapshot.clone()
 
-	if _, ok := next.entityPTIndex[entityDescr.Prototype]; ok {
+	entityPT.version = 1
+
+	if oldEntityPT, ok := next.entityPTIndex[entityDescr.Prototype]; ok {
+		entityPT.version = oldEntityPT.Version() + 1
 		next.entityPTList = slices.DeleteFunc(next.entityPTList, func(entityPT ec.EntityPT) bool {
 			return entityPT.Prototype() == entityDescr.Prototype
 		})
@@ -231,3 +259,14 @@ func (lib *_EntityLib) Watch(ctx context.Context) <-chan ec.EntityPT {
 
 	return lib.eventStream.Subscribe(ctx, lib.snapshot.Load().entityPTList...)
 }
+
+// compactIndexedMeta 去除空键与重复键，保留首次出现的顺序。
+func compactIndexedMeta(keys []string) []string {
+	var compacted []string
+	for _, key := range keys {
+		if key != "" && !slices.Contains(compacted, key) {
+			compacted = append(compacted, key)
+		}
+	}
+	return compacted
+}
@@ -20,6 +20,8 @@
 package pt
 
 import (
+	"maps"
+
 	"git.golaxy.org/core/utils/exception"
 	"git.golaxy.org/core/utils/meta"
 )
@@ -34,15 +36,17 @@ func NewComponentDescriptor(instance any) *ComponentDescriptor {
 		Name:      "",
 		Removable: false,
 		Meta:      nil,
+		Fields:    nil,
 	}
 }
 
 // ComponentDescriptor 描述实体原型中的一个内建组件。
 type ComponentDescriptor struct {
-	Instance  any       // Instance 是组件值、组件类型或已声明组件原型名。
-	Name      string    // Name 是组件加入实体时使用的名称；为空时取组件类型名。
-	Removable bool      // Removable 指示组件是否允许动态删除；默认为 false。
-	Meta      meta.Meta // Meta 是该内建组件的原型元数据。
+	Instance  any            // Instance 是组件值、组件类型或已声明组件原型名。
+	Name      string         // Name 是组件加入实体时使用的名称；为空时取组件类型名。
+	Removable bool           // Removable 指示组件是否允许动态删除；默认为 false。
+	Meta      meta.Meta      // Meta 是该内建组件的原型元数据。
+	Fields    map[string]any // Fields 是构造组件后按 JSON 规则写入的导出字段初值。
 }
 
 // SetName 设置组件在实体中的名称并返回 descr，以便链式调用。
@@ -84,3 +88,9 @@ func (descr *ComponentDescriptor) AssignMeta(m meta.Meta) *ComponentDescriptor {
 	descr.Meta = m
 	return descr
 }
+
+// SetFields 使用 dict 的副本替换导出字段初值并返回 descr。
+func (descr *ComponentDescriptor) SetFields(dict map[string]any) *ComponentDescriptor {
+	descr.Fields = maps.Clone(dict)
+	return descr
+}
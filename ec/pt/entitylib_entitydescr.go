@@ -20,6 +20,8 @@
 package pt
 
 import (
+	"slices"
+
 	"git.golaxy.org/core/ec"
 	"git.golaxy.org/core/utils/exception"
 	"git.golaxy.org/core/utils/meta"
@@ -36,6 +38,8 @@ func NewEntityDescriptor(prototype string) *EntityDescriptor {
 		Scope:                      ec.Scope_Global,
 		ComponentAwakeOnFirstTouch: false,
 		ComponentUniqueID:          false,
+		PoolSize:                   0,
+		IndexedMeta:                nil,
 		Meta:                       nil,
 	}
 }
@@ -47,6 +51,8 @@ type EntityDescriptor struct {
 	Scope                      ec.Scope  // Scope 是构造实体时使用的默认作用域。
 	ComponentAwakeOnFirstTouch bool      // ComponentAwakeOnFirstTouch 指示正常激活期间被访问的组件是否优先执行 Awake。
 	ComponentUniqueID          bool      // ComponentUniqueID 指示是否为每个组件分配唯一 ID。
+	PoolSize                   int       // PoolSize 是每个运行时缓存的已回收实体上限；不大于 0 时不启用实体池。
+	IndexedMeta                []string  // IndexedMeta 是全局实体注册时建立二级索引的元数据键。
 	Meta                       meta.Meta // Meta 是实体原型元数据。
 }
 
@@ -74,6 +80,18 @@ func (descr *EntityDescriptor) SetComponentUniqueID(b bool) *EntityDescriptor {
 	return descr
 }
 
+// SetPoolSize 设置每个运行时缓存的已回收实体上限；不大于 0 时不启用实体池。
+func (descr *EntityDescriptor) SetPoolSize(size int) *EntityDescriptor {
+	descr.PoolSize = size
+	return descr
+}
+
+// SetIndexedMeta 设置全局实体注册时建立二级索引的元数据键并返回 descr。
+func (descr *EntityDescriptor) SetIndexedMeta(keys ...string) *EntityDescriptor {
+	descr.IndexedMeta = slices.Clone(keys)
+	return descr
+}
+
 // SetMeta 使用 dict 的副本替换元数据并返回 descr。
 func (descr *EntityDescriptor) SetMeta(dict map[string]any) *EntityDescriptor {
 	descr.Meta = meta.New(dict)
@@ -0,0 +1,588 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package pt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/types"
+	"go.yaml.in/yaml/v3"
+)
+
+// LoadError 描述原型文档中的一处错误；Line 与 Column 从 1 开始，为 0 表示无法定位。
+type LoadError struct {
+	Source string // Source 是文档来源，通常为文件路径。
+	Line   int    // Line 是出错位置的行号。
+	Column int    // Column 是出错位置的列号。
+	Err    error  // Err 是原始错误，总是包装 ErrLoad。
+}
+
+// Error 返回 source:line:column 前缀的错误文本。
+func (e *LoadError) Error() string {
+	var sb strings.Builder
+	if e.Source != "" {
+		sb.WriteString(e.Source)
+	} else {
+		sb.WriteString("<document>")
+	}
+	if e.Line > 0 {
+		sb.WriteString(":")
+		sb.WriteString(strconv.Itoa(e.Line))
+		if e.Column > 0 {
+			sb.WriteString(":")
+			sb.WriteString(strconv.Itoa(e.Column))
+		}
+	}
+	sb.WriteString(": ")
+	sb.WriteString(e.Err.Error())
+	return sb.String()
+}
+
+// Unwrap 返回原始错误。
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+// EntityDeclaration 是从原型文档解析出的一条实体原型声明，可直接用于 EntityLib.Declare。
+type EntityDeclaration struct {
+	Entity     EntityDescriptor      // Entity 是实体原型描述。
+	Components []ComponentDescriptor // Components 是按文档顺序排列的内建组件描述；Instance 为组件完整原型名。
+}
+
+// NewLoader 创建向 lib 声明实体原型的文档加载器；lib 为 nil 时 panic。
+//
+// 文档可以是 JSON 或 YAML，格式与实体原型的 JSON 编码一致：顶层为单个实体对象或
+// 实体对象数组，YAML 还允许使用 --- 分隔多个文档。组件只能引用 lib.ComponentLib()
+// 中已声明的组件原型，可写为完整原型名，或写为以 pt 字段引用原型的组件对象；pt 可以是
+// 完整原型名或 {"prototype": ..., "instance": ...} 对象。组件对象还支持 name、removable、
+// meta、offset，以及按 JSON 规则初始化组件导出字段的 fields。version 由实体原型库分配，
+// 加载时忽略。
+func NewLoader(lib EntityLib) *Loader {
+	if lib == nil {
+		exception.Panicf("%w: %w: lib is nil", ErrPt, exception.ErrArgs)
+	}
+	return &Loader{
+		lib:       lib,
+		instances: map[string]reflect.Type{},
+	}
+}
+
+// Loader 从 JSON 或 YAML 文档加载实体原型。RegisterInstance 不能与加载并发调用。
+type Loader struct {
+	lib       EntityLib
+	instances map[string]reflect.Type
+}
+
+// RegisterInstance 登记文档 instance 字段可引用的自定义实体类型并返回 l，以便链式调用。
+// 文档可使用完整类型名或 reflect.Type.String() 形式的短类型名引用该类型。
+func (l *Loader) RegisterInstance(instance any) *Loader {
+	if instance == nil {
+		exception.Panicf("%w: %w: instance is nil", ErrPt, exception.ErrArgs)
+	}
+
+	instanceRT, ok := instance.(reflect.Type)
+	if !ok {
+		instanceRT = reflect.TypeOf(instance)
+	}
+
+	for instanceRT.Kind() == reflect.Pointer {
+		instanceRT = instanceRT.Elem()
+	}
+
+	if instanceRT.Name() == "" {
+		exception.Panicf("%w: anonymous entity instance not allowed", ErrPt)
+	}
+
+	if !reflect.PointerTo(instanceRT).Implements(reflect.TypeFor[ec.Entity]()) {
+		exception.Panicf("%w: entity instance %q not implement ec.Entity", ErrPt, types.FullNameRT(instanceRT))
+	}
+
+	l.instances[types.FullNameRT(instanceRT)] = instanceRT
+	l.instances[instanceRT.String()] = instanceRT
+	return l
+}
+
+// Parse 解析并校验文档，但不声明任何原型。source 仅用于错误信息。
+func (l *Loader) Parse(source string, data []byte) ([]EntityDeclaration, error) {
+	p := &_LoaderParser{
+		loader:     l,
+		source:     source,
+		prototypes: map[string]bool{},
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	var decls []EntityDeclaration
+
+	for {
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, p.syntaxError(err)
+		}
+
+		if len(doc.Content) <= 0 {
+			continue
+		}
+
+		root := doc.Content[0]
+
+		switch root.Kind {
+		case yaml.MappingNode:
+			decl, err := p.parseEntity(root)
+			if err != nil {
+				return nil, err
+			}
+			decls = append(decls, decl)
+		case yaml.SequenceNode:
+			for _, node := range root.Content {
+				decl, err := p.parseEntity(node)
+				if err != nil {
+					return nil, err
+				}
+				decls = append(decls, decl)
+			}
+		default:
+			if isNullNode(root) {
+				continue
+			}
+			return nil, p.errorf(root, "document must be an entity object or an array of entity objects")
+		}
+	}
+
+	return decls, nil
+}
+
+// Load 解析文档，全部校验通过后按文档顺序声明实体原型；解析或校验出错时返回错误，不声明任何原型。
+// 校验通过后若 EntityLib.Declare 在中途 panic，此前已声明的原型保持生效。
+func (l *Loader) Load(source string, data []byte) ([]ec.EntityPT, error) {
+	decls, err := l.Parse(source, data)
+	if err != nil {
+		return nil, err
+	}
+
+	entityPTs := make([]ec.EntityPT, 0, len(decls))
+
+	for _, decl := range decls {
+		comps := make([]any, 0, len(decl.Components))
+		for _, comp := range decl.Components {
+			comps = append(comps, comp)
+		}
+		entityPTs = append(entityPTs, l.lib.Declare(decl.Entity, comps...))
+	}
+
+	return entityPTs, nil
+}
+
+// LoadFile 读取 path 指向的文档并加载其中的实体原型。
+func (l *Loader) LoadFile(path string) ([]ec.EntityPT, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &LoadError{Source: path, Err: fmt.Errorf("%w: %w", ErrLoad, err)}
+	}
+	return l.Load(path, data)
+}
+
+type _LoaderParser struct {
+	loader     *Loader
+	source     string
+	prototypes map[string]bool
+}
+
+func (p *_LoaderParser) parseEntity(node *yaml.Node) (EntityDeclaration, error) {
+	if node.Kind != yaml.MappingNode {
+		return EntityDeclaration{}, p.errorf(node, "entity must be an object")
+	}
+
+	decl := EntityDeclaration{
+		Entity: EntityDescriptor{Scope: ec.Scope_Global},
+	}
+
+	var componentsNode *yaml.Node
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+
+		var err error
+
+		switch keyNode.Value {
+		case "prototype":
+			decl.Entity.Prototype, err = p.parseString(valueNode, keyNode.Value)
+			if err == nil && decl.Entity.Prototype == "" {
+				err = p.errorf(valueNode, "prototype can't be empty")
+			}
+			if err == nil && p.prototypes[decl.Entity.Prototype] {
+				err = p.errorf(valueNode, "entity %q is declared more than once", decl.Entity.Prototype)
+			}
+		case "version":
+			_, err = p.parseInt(valueNode, keyNode.Value)
+		case "instance":
+			var instance string
+			instance, err = p.parseString(valueNode, keyNode.Value)
+			if err == nil && instance != "" {
+				instanceRT, ok := p.loader.instances[instance]
+				if !ok {
+					err = p.errorf(valueNode, "entity instance %q was not registered to the loader", instance)
+				}
+				decl.Entity.Instance = instanceRT
+			}
+		case "scope":
+			var scope string
+			scope, err = p.parseString(valueNode, keyNode.Value)
+			if err == nil {
+				var ok bool
+				decl.Entity.Scope, ok = parseScope(scope)
+				if !ok {
+					err = p.errorf(valueNode, "invalid scope %q", scope)
+				}
+			}
+		case "component_awake_on_first_touch":
+			decl.Entity.ComponentAwakeOnFirstTouch, err = p.parseBool(valueNode, keyNode.Value)
+		case "component_unique_id":
+			decl.Entity.ComponentUniqueID, err = p.parseBool(valueNode, keyNode.Value)
+		case "pool_size":
+			decl.Entity.PoolSize, err = p.parseInt(valueNode, keyNode.Value)
+		case "indexed_meta":
+			decl.Entity.IndexedMeta, err = p.parseStrings(valueNode, keyNode.Value)
+		case "meta":
+			var dict map[string]any
+			dict, err = p.parseMap(valueNode, keyNode.Value)
+			if err == nil && dict != nil {
+				decl.Entity.SetMeta(dict)
+			}
+		case "components":
+			componentsNode = valueNode
+		default:
+			err = p.errorf(keyNode, "unknown entity field %q", keyNode.Value)
+		}
+
+		if err != nil {
+			return EntityDeclaration{}, err
+		}
+	}
+
+	if decl.Entity.Prototype == "" {
+		return EntityDeclaration{}, p.errorf(node, "entity prototype is missing")
+	}
+
+	if componentsNode != nil && !isNullNode(componentsNode) {
+		if componentsNode.Kind != yaml.SequenceNode {
+			return EntityDeclaration{}, p.errorf(componentsNode, "components must be an array")
+		}
+
+		names := map[string]bool{}
+
+		for i, compNode := range componentsNode.Content {
+			compDescr, compRT, err := p.parseComponent(i, compNode)
+			if err != nil {
+				return EntityDeclaration{}, err
+			}
+
+			name := compDescr.Name
+			if name == "" {
+				name = types.NameRT(compRT)
+			}
+			if names[name] {
+				return EntityDeclaration{}, p.errorf(compNode, "component name %q is used more than once", name)
+			}
+			names[name] = true
+
+			decl.Components = append(decl.Components, compDescr)
+		}
+	}
+
+	p.prototypes[decl.Entity.Prototype] = true
+
+	return decl, nil
+}
+
+func (p *_LoaderParser) parseComponent(offset int, node *yaml.Node) (ComponentDescriptor, reflect.Type, error) {
+	if node.Kind == yaml.ScalarNode {
+		compPT, err := p.lookupComponent(node, node.Value, "")
+		if err != nil {
+			return ComponentDescriptor{}, nil, err
+		}
+		return ComponentDescriptor{Instance: compPT.Prototype()}, compPT.InstanceRT().Elem(), nil
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return ComponentDescriptor{}, nil, p.errorf(node, "component must be a prototype name or an object")
+	}
+
+	var descr ComponentDescriptor
+	var ptNode, fieldsNode *yaml.Node
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+
+		var err error
+
+		switch keyNode.Value {
+		case "pt":
+			ptNode = valueNode
+		case "offset":
+			var v int
+			v, err = p.parseInt(valueNode, keyNode.Value)
+			if err == nil && v != offset {
+				err = p.errorf(valueNode, "offset %d does not match the component position %d", v, offset)
+			}
+		case "name":
+			descr.Name, err = p.parseString(valueNode, keyNode.Value)
+		case "removable":
+			descr.Removable, err = p.parseBool(valueNode, keyNode.Value)
+		case "meta":
+			var dict map[string]any
+			dict, err = p.parseMap(valueNode, keyNode.Value)
+			if err == nil && dict != nil {
+				descr.SetMeta(dict)
+			}
+		case "fields":
+			fieldsNode = valueNode
+		default:
+			err = p.errorf(keyNode, "unknown component field %q", keyNode.Value)
+		}
+
+		if err != nil {
+			return ComponentDescriptor{}, nil, err
+		}
+	}
+
+	if ptNode == nil {
+		return ComponentDescriptor{}, nil, p.errorf(node, "component pt is missing")
+	}
+
+	var compPT ec.ComponentPT
+	var err error
+
+	switch ptNode.Kind {
+	case yaml.ScalarNode:
+		compPT, err = p.lookupComponent(ptNode, ptNode.Value, "")
+	case yaml.MappingNode:
+		var prototype, instance string
+		var prototypeNode *yaml.Node
+
+		for i := 0; i+1 < len(ptNode.Content); i += 2 {
+			keyNode, valueNode := ptNode.Content[i], ptNode.Content[i+1]
+
+			switch keyNode.Value {
+			case "prototype":
+				prototypeNode = valueNode
+				prototype, err = p.parseString(valueNode, keyNode.Value)
+			case "instance":
+				instance, err = p.parseString(valueNode, keyNode.Value)
+			default:
+				err = p.errorf(keyNode, "unknown component pt field %q", keyNode.Value)
+			}
+
+			if err != nil {
+				return ComponentDescriptor{}, nil, err
+			}
+		}
+
+		if prototypeNode == nil {
+			return ComponentDescriptor{}, nil, p.errorf(ptNode, "component prototype is missing")
+		}
+
+		compPT, err = p.lookupComponent(prototypeNode, prototype, instance)
+	default:
+		err = p.errorf(ptNode, "component pt must be a prototype name or an object")
+	}
+	if err != nil {
+		return ComponentDescriptor{}, nil, err
+	}
+
+	descr.Instance = compPT.Prototype()
+	compRT := compPT.InstanceRT().Elem()
+
+	if fieldsNode != nil && !isNullNode(fieldsNode) {
+		if fieldsNode.Kind != yaml.MappingNode {
+			return ComponentDescriptor{}, nil, p.errorf(fieldsNode, "fields must be an object")
+		}
+
+		descr.Fields = map[string]any{}
+
+		for i := 0; i+1 < len(fieldsNode.Content); i += 2 {
+			keyNode, valueNode := fieldsNode.Content[i], fieldsNode.Content[i+1]
+
+			var value any
+			if err := valueNode.Decode(&value); err != nil {
+				return ComponentDescriptor{}, nil, p.errorf(valueNode, "invalid field %q value: %s", keyNode.Value, trimYAMLError(err))
+			}
+
+			if _, err := encodeComponentFields(compRT, map[string]any{keyNode.Value: value}); err != nil {
+				return ComponentDescriptor{}, nil, p.errorf(keyNode, "component %q field %q: %s", compPT.Prototype(), keyNode.Value, trimJSONError(err))
+			}
+
+			descr.Fields[keyNode.Value] = value
+		}
+	}
+
+	return descr, compRT, nil
+}
+
+func (p *_LoaderParser) lookupComponent(node *yaml.Node, prototype, instance string) (ec.ComponentPT, error) {
+	if prototype == "" {
+		return nil, p.errorf(node, "component prototype can't be empty")
+	}
+
+	compPT, ok := p.loader.lib.ComponentLib().Get(prototype)
+	if !ok {
+		return nil, p.errorf(node, "component %q was not declared", prototype)
+	}
+
+	if instance != "" && instance != compPT.InstanceRT().Elem().String() {
+		return nil, p.errorf(node, "component %q instance mismatch: got %q, declared %q", prototype, instance, compPT.InstanceRT().Elem().String())
+	}
+
+	return compPT, nil
+}
+
+func (p *_LoaderParser) parseString(node *yaml.Node, field string) (string, error) {
+	if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!str" {
+		if isNullNode(node) {
+			return "", nil
+		}
+		return "", p.errorf(node, "%s must be a string", field)
+	}
+	return node.Value, nil
+}
+
+func (p *_LoaderParser) parseBool(node *yaml.Node, field string) (bool, error) {
+	var v bool
+	if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!bool" || node.Decode(&v) != nil {
+		return false, p.errorf(node, "%s must be a bool", field)
+	}
+	return v, nil
+}
+
+func (p *_LoaderParser) parseInt(node *yaml.Node, field string) (int, error) {
+	var v int
+	if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!int" || node.Decode(&v) != nil {
+		return 0, p.errorf(node, "%s must be an integer", field)
+	}
+	return v, nil
+}
+
+func (p *_LoaderParser) parseStrings(node *yaml.Node, field string) ([]string, error) {
+	if isNullNode(node) {
+		return nil, nil
+	}
+	if node.Kind != yaml.SequenceNode {
+		return nil, p.errorf(node, "%s must be an array", field)
+	}
+	strs := make([]string, 0, len(node.Content))
+	for _, itemNode := range node.Content {
+		str, err := p.parseString(itemNode, field+" item")
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
+
+func (p *_LoaderParser) parseMap(node *yaml.Node, field string) (map[string]any, error) {
+	if isNullNode(node) {
+		return nil, nil
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil, p.errorf(node, "%s must be an object", field)
+	}
+	var dict map[string]any
+	if err := node.Decode(&dict); err != nil {
+		return nil, p.errorf(node, "invalid %s: %s", field, trimYAMLError(err))
+	}
+	return dict, nil
+}
+
+func (p *_LoaderParser) errorf(node *yaml.Node, format string, args ...any) error {
+	return &LoadError{
+		Source: p.source,
+		Line:   node.Line,
+		Column: node.Column,
+		Err:    fmt.Errorf("%w: "+format, append([]any{ErrLoad}, args...)...),
+	}
+}
+
+var yamlLineErrorRegexp = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+func (p *_LoaderParser) syntaxError(err error) error {
+	loadErr := &LoadError{Source: p.source}
+
+	if matches := yamlLineErrorRegexp.FindStringSubmatch(err.Error()); matches != nil {
+		loadErr.Line, _ = strconv.Atoi(matches[1])
+		loadErr.Err = fmt.Errorf("%w: %s", ErrLoad, matches[2])
+	} else {
+		loadErr.Err = fmt.Errorf("%w: %s", ErrLoad, trimYAMLError(err))
+	}
+
+	return loadErr
+}
+
+func isNullNode(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
+
+func parseScope(s string) (ec.Scope, bool) {
+	for _, scope := range []ec.Scope{ec.Scope_Local, ec.Scope_Global} {
+		if strings.EqualFold(s, scope.String()) || strings.EqualFold(s, strings.TrimPrefix(scope.String(), "Scope_")) {
+			return scope, true
+		}
+	}
+	return 0, false
+}
+
+func trimYAMLError(err error) string {
+	return strings.TrimPrefix(err.Error(), "yaml: ")
+}
+
+func trimJSONError(err error) string {
+	return strings.TrimPrefix(err.Error(), "json: ")
+}
+
+// encodeComponentFields 将 fields 编码为 JSON，并校验其能否无未知字段地写入 compRT 的实例。
+func encodeComponentFields(compRT reflect.Type, fields map[string]any) ([]byte, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(reflect.New(compRT).Interface()); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -26,5 +26,6 @@ import (
 )
 
 var (
-	ErrPt = fmt.Errorf("%w: pt", exception.ErrCore) // ErrPt 是原型模块错误的共同根错误。
+	ErrPt   = fmt.Errorf("%w: pt", exception.ErrCore) // ErrPt 是原型模块错误的共同根错误。
+	ErrLoad = fmt.Errorf("%w: load", ErrPt)           // ErrLoad 标识原型文档加载错误。
 )
@@ -95,3 +95,24 @@ func (u _UnsafeComponent) ManagedRuntimeLateUpdateHandle(lateUpdateHandle event.
 func (u _UnsafeComponent) ManagedUnbindRuntimeHandles() {
 	u.managedUnbindRuntimeHandles()
 }
+
+// SetUpdateSuspended 设置组件是否暂停接收 Runtime 更新事件。
+func (u _UnsafeComponent) SetUpdateSuspended(b bool) {
+	u.setUpdateSuspended(b)
+}
+
+// UpdateSuspended 返回组件是否暂停接收 Runtime 更新事件。
+func (u _UnsafeComponent) UpdateSuspended() bool {
+	return u.getUpdateSuspended()
+}
+
+// Recycle 重置已销毁的组件以便放回实体池；zeroInstance 为 true 时清零整个组件实例。
+// 组件 ID、名称、所属实体、Destroyed 状态与已关闭的 Scope 会被保留，使旧引用继续观察到组件已销毁。
+func (u _UnsafeComponent) Recycle(zeroInstance bool) {
+	u.recycle(zeroInstance)
+}
+
+// Renew 将已回收的组件恢复为 Born 状态，供实体池重新装配。
+func (u _UnsafeComponent) Renew() {
+	u.renew()
+}
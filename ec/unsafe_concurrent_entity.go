@@ -36,3 +36,8 @@ type _UnsafeConcurrentEntity struct {
 func (u _UnsafeConcurrentEntity) Instance() Entity {
 	return u.getInstance()
 }
+
+// Incarnation 返回引用绑定的实体生命周期序号；实体从实体池复用后，实体实例的序号随之改变。
+func (u _UnsafeConcurrentEntity) Incarnation() uint64 {
+	return u.getIncarnation().seq
+}
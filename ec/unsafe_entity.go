@@ -55,7 +55,7 @@ func (u _UnsafeEntity) SetID(id uid.ID) {
 	u.setID(id)
 }
 
-// SetPT 绑定实体原型。
+// SetPT 以原子方式绑定实体原型，其他 goroutine 可同时经 ConcurrentEntity 读取 PT。
 func (u _UnsafeEntity) SetPT(prototype EntityPT) {
 	u.setPT(prototype)
 }
@@ -120,6 +120,15 @@ func (u _UnsafeEntity) ComponentList() *generic.FreeList[Component] {
 	return u.getComponentList()
 }
 
+// PeekComponent 按名称查询组件，不触发首次访问 Awake；不存在时返回 nil。
+func (u _UnsafeEntity) PeekComponent(name string) Component {
+	slotIdx, ok := u.getComponentNameIndex().Get(name)
+	if !ok {
+		return nil
+	}
+	return u.getComponentList().Get(slotIdx).V
+}
+
 // SetTreeNodeState 设置实体树节点状态。
 func (u _UnsafeEntity) SetTreeNodeState(state TreeNodeState) {
 	u.setTreeNodeState(state)
@@ -149,3 +158,14 @@ func (u _UnsafeEntity) EmitEventTreeNodeDetachParent(parentID uid.ID) {
 func (u _UnsafeEntity) EmitEventTreeNodeMoveTo(fromParentID, toParentID uid.ID) {
 	u.emitEventTreeNodeMoveTo(fromParentID, toParentID)
 }
+
+// Recycle 重置已销毁的实体以便放回实体池；zeroInstance 为 true 时清零整个实体实例。
+// 实体 ID、原型、Destroyed 状态、已关闭的上下文与已兑现的 Terminated 会被保留，使旧引用继续观察到实体已销毁。
+func (u _UnsafeEntity) Recycle(zeroInstance bool) {
+	u.recycle(zeroInstance)
+}
+
+// Renew 将已回收的实体恢复为使用 options 的 Born 状态，供实体池重新装配。
+func (u _UnsafeEntity) Renew(options EntityOptions) {
+	u.renew(options)
+}
@@ -0,0 +1,51 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package ec
+
+import (
+	"reflect"
+	"time"
+)
+
+// UpdateInterval 描述组件 Update 与 LateUpdate 的节流间隔；零值表示每帧更新。
+type UpdateInterval struct {
+	Frames   int64         // 每隔多少帧更新一次；大于 1 时生效。
+	Duration time.Duration // 两次更新之间的最短时长；大于 0 时生效，并优先于 Frames。
+}
+
+// Throttled 报告间隔是否需要节流，即不是每帧更新。
+func (i UpdateInterval) Throttled() bool {
+	return i.Duration > 0 || i.Frames > 1
+}
+
+// ComponentUpdateInterval 由组件指针类型实现，声明组件原型的 Update 与 LateUpdate 节流间隔。
+// 运行时按实体 ID 将同一原型的更新错开到不同帧，避免同一帧集中更新；未实现时每帧更新。
+type ComponentUpdateInterval interface {
+	// UpdateInterval 返回节流间隔；仅在声明组件原型时以零值实例调用一次。
+	UpdateInterval() UpdateInterval
+}
+
+// LookupUpdateInterval 返回组件指针类型 compRT 按 ComponentUpdateInterval 声明的节流间隔。
+func LookupUpdateInterval(compRT reflect.Type) UpdateInterval {
+	if !compRT.Implements(reflect.TypeFor[ComponentUpdateInterval]()) {
+		return UpdateInterval{}
+	}
+	return reflect.New(compRT.Elem()).Interface().(ComponentUpdateInterval).UpdateInterval()
+}
@@ -0,0 +1,48 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package ec
+
+import (
+	"reflect"
+)
+
+// 预定义的 Update 与 LateUpdate 执行分组，组件可在分组值上加减偏移量细分组内顺序。
+const (
+	UpdateGroup_Input        int32 = -2000 // 输入采集。
+	UpdateGroup_Physics      int32 = -1000 // 物理模拟。
+	UpdateGroup_Default      int32 = 0     // 未声明执行顺序的组件与实体。
+	UpdateGroup_AI           int32 = 1000  // AI 与玩法逻辑。
+	UpdateGroup_Presentation int32 = 2000  // 表现与状态同步。
+)
+
+// ComponentUpdateOrder 由组件指针类型实现，声明组件原型的 Update 与 LateUpdate 执行顺序。
+// 运行时按顺序值升序逐组调用，顺序值相同时保持绑定顺序；未实现时顺序值为 UpdateGroup_Default。
+type ComponentUpdateOrder interface {
+	// UpdateOrder 返回执行顺序值；仅在声明组件原型时以零值实例调用一次。
+	UpdateOrder() int32
+}
+
+// LookupUpdateOrder 返回组件指针类型 compRT 按 ComponentUpdateOrder 声明的执行顺序值。
+func LookupUpdateOrder(compRT reflect.Type) int32 {
+	if !compRT.Implements(reflect.TypeFor[ComponentUpdateOrder]()) {
+		return UpdateGroup_Default
+	}
+	return reflect.New(compRT.Elem()).Interface().(ComponentUpdateOrder).UpdateOrder()
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"fmt"
+	"slices"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/ec/pt"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/service"
+	"git.golaxy.org/core/utils/corectx"
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// BuildEntityBatch 创建绑定到 provider 当前运行时的批量实体构建器。
+func BuildEntityBatch(provider corectx.CurrentContextProvider) *EntityBatchCreator {
+	if provider == nil {
+		exception.Panicf("%w: %w: provider is nil", ErrCore, ErrArgs)
+	}
+	return &EntityBatchCreator{
+		rtCtx: runtime.Current(provider),
+	}
+}
+
+// EntityBatchCreator 构造一批实体，并通过一次批量加入与激活流程将其交给运行时。
+// 批内实体先全部完成 Awake 再统一 Start，只派发批量实体激活事件。
+type EntityBatchCreator struct {
+	rtCtx    runtime.Context
+	items    []_EntityBatchItem
+	parentID uid.ID
+}
+
+type _EntityBatchItem struct {
+	creator *EntityCreator
+	count   int
+}
+
+// Add 追加 count 个按 creator 配置构造的实体；creator 须绑定同一运行时，且不应设置持久化 ID。
+func (b *EntityBatchCreator) Add(creator *EntityCreator, count int) *EntityBatchCreator {
+	if creator == nil {
+		exception.Panicf("%w: %w: creator is nil", ErrCore, ErrArgs)
+	}
+	if creator.rtCtx != b.rtCtx {
+		exception.Panicf("%w: %w: creator is bound to another runtime", ErrCore, ErrArgs)
+	}
+	if count <= 0 {
+		return b
+	}
+	b.items = append(b.items, _EntityBatchItem{creator: creator, count: count})
+	return b
+}
+
+// AddPrototype 追加 count 个使用原型默认配置构造的实体。
+func (b *EntityBatchCreator) AddPrototype(prototype string, count int) *EntityBatchCreator {
+	return b.Add(BuildEntity(b.rtCtx, prototype), count)
+}
+
+// SetParentID 设置整批实体共同的父实体；批内实体激活完成后按添加顺序挂到该实体下。
+func (b *EntityBatchCreator) SetParentID(id uid.ID) *EntityBatchCreator {
+	b.parentID = id
+	return b
+}
+
+// Count 返回待创建的实体总数。
+func (b *EntityBatchCreator) Count() int {
+	n := 0
+	for _, item := range b.items {
+		n += item.count
+	}
+	return n
+}
+
+// New 构造全部实体并一次性加入绑定运行时的实体管理器，按添加顺序返回实体。
+// 任一实体的元数据绑定、登记、激活或挂接父实体失败时，整批实体都不会保留：
+// 元数据绑定或登记失败时取自实体池的实体被放回实体池，已加入运行时的实体会被逆序销毁，
+// 激活被中止时返回 ErrEntityBatchAborted。
+func (b *EntityBatchCreator) New() ([]ec.Entity, error) {
+	if b.rtCtx == nil {
+		exception.Panicf("%w: rtCtx is nil", ErrCore)
+	}
+
+	if len(b.items) <= 0 {
+		return nil, fmt.Errorf("%w: %w: entity batch is empty", ErrCore, ErrArgs)
+	}
+
+	if !b.parentID.IsNil() {
+		if _, ok := b.rtCtx.EntityManager().GetEntity(b.parentID); !ok {
+			return nil, fmt.Errorf("%w: parent entity %q not exists", ErrCore, b.parentID)
+		}
+	}
+
+	entities := make([]ec.Entity, 0, b.Count())
+	pooled := make([]bool, 0, b.Count())
+
+	for _, item := range b.items {
+		entityPT := pt.For(service.Current(b.rtCtx), item.creator.prototype)
+
+		for range item.count {
+			entity, fromPool := item.creator.construct(entityPT)
+			entities = append(entities, entity)
+			pooled = append(pooled, fromPool)
+
+			if err := bindComponentsMeta(entity); err != nil {
+				for i, entity := range entities {
+					releaseConstructedEntity(b.rtCtx, entity, pooled[i])
+				}
+				return nil, err
+			}
+		}
+	}
+
+	if err := b.rtCtx.EntityManager().AddEntities(entities); err != nil {
+		for i, entity := range entities {
+			releaseConstructedEntity(b.rtCtx, entity, pooled[i])
+		}
+		return nil, err
+	}
+
+	if slices.ContainsFunc(entities, func(entity ec.Entity) bool { return entity.State() > ec.EntityState_Alive }) {
+		destroyEntities(entities)
+		return nil, ErrEntityBatchAborted
+	}
+
+	if !b.parentID.IsNil() {
+		for _, entity := range entities {
+			if err := b.rtCtx.EntityTree().AddChild(b.parentID, entity.ID()); err != nil {
+				destroyEntities(entities)
+				return nil, err
+			}
+		}
+	}
+
+	return entities, nil
+}
+
+func destroyEntities(entities []ec.Entity) {
+	for i := len(entities) - 1; i >= 0; i-- {
+		entities[i].Destroy()
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"git.golaxy.org/core/service"
 	"git.golaxy.org/core/utils/corectx"
 	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/generic"
 	"git.golaxy.org/core/utils/iface"
 	"git.golaxy.org/core/utils/meta"
 	"git.golaxy.org/core/utils/option"
@@ -48,18 +49,21 @@ func BuildEntity(provider corectx.CurrentContextProvider, prototype string) *Ent
 type EntityCreator struct {
 	rtCtx     runtime.Context
 	prototype string
+	instance  bool
 	meta      meta.Meta
 	settings  []option.Setting[ec.EntityOptions]
 }
 
 // SetInstanceFace 设置用于扩展实体能力的自定义实例及其接口缓存。
 func (c *EntityCreator) SetInstanceFace(face iface.Face[ec.Entity]) *EntityCreator {
+	c.instance = true
 	c.settings = append(c.settings, ec.With.InstanceFace(face))
 	return c
 }
 
 // SetInstance 设置用于扩展实体能力的自定义实例。
 func (c *EntityCreator) SetInstance(instance ec.Entity) *EntityCreator {
+	c.instance = true
 	c.settings = append(c.settings, ec.With.InstanceFace(iface.NewFaceT(instance)))
 	return c
 }
@@ -132,20 +136,57 @@ func (c *EntityCreator) AssignMeta(m meta.Meta) *EntityCreator {
 }
 
 // New 根据原型构造实体，并将其加入绑定运行时的实体管理器。
+// 原型启用实体池且未设置自定义实例时，优先复用实体池中已回收的实体，复用的实体总会分配新的 ID。
+// 加入前会按 pt.BindComponentMeta 将原型与构建器元数据写入组件的标签字段，转换失败时返回错误；
+// 元数据绑定或加入实体管理器失败时，取自实体池的实体会被放回实体池。
 func (c *EntityCreator) New() (ec.Entity, error) {
 	if c.rtCtx == nil {
 		exception.Panicf("%w: rtCtx is nil", ErrCore)
 	}
 
-	entity := pt.For(service.Current(c.rtCtx), c.prototype).Construct(c.settings...)
+	entity, pooled := c.construct(pt.For(service.Current(c.rtCtx), c.prototype))
+
+	if err := bindComponentsMeta(entity); err != nil {
+		releaseConstructedEntity(c.rtCtx, entity, pooled)
+		return nil, err
+	}
 
 	if err := c.rtCtx.EntityManager().AddEntity(entity); err != nil {
+		releaseConstructedEntity(c.rtCtx, entity, pooled)
 		return nil, err
 	}
 
 	return entity, nil
 }
 
+// construct 构造实体，并报告实体是否取自实体池。
+func (c *EntityCreator) construct(entityPT ec.EntityPT) (ec.Entity, bool) {
+	if entityPT.PoolSize() > 0 && !c.instance {
+		if entity, components, ok := c.rtCtx.EntityPool().Get(entityPT); ok {
+			return entityPT.Reconstruct(entity, components, c.settings...), true
+		}
+	}
+	return entityPT.Construct(c.settings...), false
+}
+
+// releaseConstructedEntity 释放构造后未加入运行时的实体，取自实体池的实体被放回实体池。
+func releaseConstructedEntity(rtCtx runtime.Context, entity ec.Entity, pooled bool) {
+	if pooled {
+		releaseUnusedEntity(rtCtx, entity)
+		return
+	}
+	entity.AsyncScope().Close()
+}
+
+func bindComponentsMeta(entity ec.Entity) error {
+	var err error
+	ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
+		err = pt.BindComponentMeta(entity, slot.V)
+		return err == nil
+	})
+	return err
+}
+
 func (c *EntityCreator) withMeta() option.Setting[ec.EntityOptions] {
 	return func(o *ec.EntityOptions) {
 		o.Meta = c.meta
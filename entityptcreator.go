@@ -82,6 +82,24 @@ func (c *EntityPTCreator) SetComponentUniqueID(b bool) *EntityPTCreator {
 	return c
 }
 
+// SetPoolSize 设置每个运行时缓存的已回收实体上限；不大于 0 时不启用实体池。
+func (c *EntityPTCreator) SetPoolSize(size int) *EntityPTCreator {
+	if c.descr == nil {
+		exception.Panicf("%w: descr is nil", ErrCore)
+	}
+	c.descr.SetPoolSize(size)
+	return c
+}
+
+// SetIndexedMeta 设置全局实体注册时建立二级索引的元数据键，可通过 service.EntityManager 按键值查询实体。
+func (c *EntityPTCreator) SetIndexedMeta(keys ...string) *EntityPTCreator {
+	if c.descr == nil {
+		exception.Panicf("%w: descr is nil", ErrCore)
+	}
+	c.descr.SetIndexedMeta(keys...)
+	return c
+}
+
 // SetMeta 用 dict 替换原型元数据。
 func (c *EntityPTCreator) SetMeta(dict map[string]any) *EntityPTCreator {
 	if c.descr == nil {
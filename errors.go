@@ -31,4 +31,14 @@ var (
 	ErrArgs     = exception.ErrArgs                  // 参数错误。
 	ErrRuntime  = fmt.Errorf("%w: runtime", ErrCore) // 运行时错误。
 	ErrService  = fmt.Errorf("%w: service", ErrCore) // 服务错误。
+
+	ErrEntityBatchAborted = fmt.Errorf("%w: entity batch aborted", ErrCore) // 批量实体的激活被中止，整批实体已销毁。
+
+	ErrEntityDead            = fmt.Errorf("%w: entity is dead", ErrCore)          // 实体已失活、销毁或已被实体池回收。
+	ErrComponentNotFound     = fmt.Errorf("%w: component not found", ErrCore)     // 实体上不存在指定名称的组件。
+	ErrComponentRemoved      = fmt.Errorf("%w: component removed", ErrCore)       // 组件正在移除或已销毁。
+	ErrComponentTypeMismatch = fmt.Errorf("%w: component type mismatch", ErrCore) // 组件无法断言为调用方要求的类型。
+
+	ErrUnknownMessage      = fmt.Errorf("%w: unknown message", ErrCore)       // 实体原型没有处理该消息类型的组件。
+	ErrMessageTypeMismatch = fmt.Errorf("%w: message type mismatch", ErrCore) // 消息处理器的响应类型与调用方要求的类型不符。
 )
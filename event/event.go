@@ -63,7 +63,7 @@ type _Subscriber struct {
 // Event 的零值可用，默认允许递归且不会恢复订阅者 panic。Event 不支持并发访问。
 type Event struct {
 	autoRecover bool
-	reportError chan error
+	reportPanic exception.PanicReporter
 	recursion   EventRecursion
 	disabled    bool
 	subscribers generic.FreeList[_Subscriber]
@@ -71,17 +71,17 @@ type Event struct {
 }
 
 // PanicHandling 返回订阅者 panic 的恢复与上报设置。
-func (event *Event) PanicHandling() (autoRecover bool, reportError chan error) {
-	return event.autoRecover, event.reportError
+func (event *Event) PanicHandling() (autoRecover bool, reportPanic exception.PanicReporter) {
+	return event.autoRecover, event.reportPanic
 }
 
 // SetPanicHandling 设置订阅者 panic 的处理方式。
 //
-// autoRecover 为 true 时，panic 会被转换为带堆栈的错误并尝试非阻塞写入 reportError；
-// 写入失败或 reportError 为 nil 时错误只作为本次调用结果被内部丢弃，派发继续。
-func (event *Event) SetPanicHandling(autoRecover bool, reportError chan error) {
+// autoRecover 为 true 时，panic 会被转换为带堆栈的错误并在 recover 处交给 reportPanic；
+// reportPanic 为 nil 时错误只作为本次调用结果被内部丢弃，派发继续。
+func (event *Event) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	event.autoRecover = autoRecover
-	event.reportError = reportError
+	event.reportPanic = reportPanic
 }
 
 // Recursion 返回当前递归派发策略。
@@ -173,7 +173,7 @@ func (event *Event) emit(fun generic.Func1[iface.Cache, bool]) {
 
 		slot.V.receivedEmitted = event.emitted
 
-		ret, panicErr := fun.Call(event.autoRecover, event.reportError, slot.V.face.Cache)
+		ret, panicErr := fun.Call(event.autoRecover, event.reportPanic, slot.V.face.Cache)
 		if panicErr != nil {
 			return true
 		}
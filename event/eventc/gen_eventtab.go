@@ -70,6 +70,9 @@ package %s
 		fmt.Fprintf(importCode, `
 	%s "%s"`, packageEventAlias, packageEventPath)
 
+		fmt.Fprintf(importCode, `
+	"%s"`, packageExceptionPath)
+
 		fmt.Fprintf(importCode, "\n)\n")
 
 		fmt.Fprint(code, importCode.String())
@@ -166,9 +169,9 @@ var (`)
 		fmt.Fprintf(code, `
 type %[1]s [%[2]d]%[4]sEvent
 
-func (eventTab *%[1]s) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *%[1]s) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
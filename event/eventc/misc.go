@@ -35,7 +35,8 @@ import (
 )
 
 const (
-	packageEventPath = "git.golaxy.org/core/event"
+	packageEventPath     = "git.golaxy.org/core/event"
+	packageExceptionPath = "git.golaxy.org/core/utils/exception"
 )
 
 const (
@@ -19,10 +19,12 @@
 
 package event
 
+import "git.golaxy.org/core/utils/exception"
+
 // IEventCtrl 统一控制一个事件或一组事件。
 type IEventCtrl interface {
 	// SetPanicHandling 设置订阅者 panic 的恢复与上报方式。
-	SetPanicHandling(autoRecover bool, reportError chan error)
+	SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter)
 	// SetRecursion 设置递归派发策略。
 	SetRecursion(recursion EventRecursion)
 	// SetEnabled 设置事件是否启用；禁用会解绑全部订阅者。
@@ -19,15 +19,17 @@
 
 package event
 
+import "git.golaxy.org/core/utils/exception"
+
 // CombineEventTab 将多张事件表组合成一个 IEventTab 与 IEventCtrl。
 //
 // 查询按切片顺序进行，控制操作会依次作用于每张表。零值可用，但元素不能为 nil。
 type CombineEventTab []IEventTab
 
 // SetPanicHandling 为全部事件表设置订阅者 panic 的恢复与上报方式。
-func (c *CombineEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (c *CombineEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for _, tab := range *c {
-		tab.Ctrl().SetPanicHandling(autoRecover, reportError)
+		tab.Ctrl().SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
@@ -21,6 +21,7 @@ package extension
 
 import (
 	"fmt"
+	"log/slog"
 	"reflect"
 
 	"git.golaxy.org/core/utils/iface"
@@ -40,4 +41,6 @@ type AddInStatus interface {
 	Reflected() reflect.Value
 	// State 返回插件当前的生命周期状态。
 	State() AddInState
+	// Logger 返回在所属上下文日志记录器上附带 addin 属性的日志记录器。
+	Logger() *slog.Logger
 }
@@ -0,0 +1,36 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+//go:generate stringer -type JournalKind
+package core
+
+// JournalKind 标识生命周期日志记录的类别。
+type JournalKind int8
+
+const (
+	JournalKind_EntityState       JournalKind = iota // 实体生命周期状态变化，From 与 To 为状态名。
+	JournalKind_ComponentState                       // 组件生命周期状态变化，From 与 To 为状态名。
+	JournalKind_AddComponent                         // 实体新增组件。
+	JournalKind_RemoveComponent                      // 实体开始删除组件。
+	JournalKind_TreeNodeAdd                          // 实体加入实体树，To 为父实体 ID。
+	JournalKind_TreeNodeRemove                       // 实体离开实体树，From 为原父实体 ID。
+	JournalKind_TreeNodeMove                         // 实体在实体树中移动，From 与 To 为移动前后的父实体 ID。
+	JournalKind_ActivationPanic                      // 激活实体时生命周期回调发生 panic，Error 为 panic 信息。
+	JournalKind_ActivationAborted                    // 实体或组件的激活流程被中止。
+)
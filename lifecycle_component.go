@@ -55,3 +55,9 @@ type LifecycleComponentOnDisable interface {
 type LifecycleComponentDispose interface {
 	Dispose()
 }
+
+// LifecycleComponentReset 在启用实体池的实体销毁后、内建组件放回实体池前调用，用于清理自定义字段以便复用。
+// 未实现该接口的组件会被整体清零；发生 panic 时所属实体不会放回实体池。
+type LifecycleComponentReset interface {
+	Reset()
+}
@@ -44,3 +44,9 @@ type LifecycleEntityShut interface {
 type LifecycleEntityDispose interface {
 	Dispose()
 }
+
+// LifecycleEntityReset 在启用实体池的实体销毁后、放回实体池前调用，用于清理自定义字段以便复用。
+// 未实现该接口的实体会被整体清零；发生 panic 时实体不会放回实体池。
+type LifecycleEntityReset interface {
+	Reset()
+}
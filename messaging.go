@@ -0,0 +1,134 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/generic"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// Ask 将 req 经实体所属 Runtime 的邮箱投递给实体原型中处理 Req 的内建组件，并以处理器的响应完成 Future。
+// 处理器由组件实现 ec.ComponentMessageHandlers 显式声明，在组件原型声明时登记；实体原型没有处理器时以 ErrUnknownMessage 完成，
+// 处理器的响应类型无法赋值给 Resp 时以 ErrMessageTypeMismatch 完成，处理器没有响应值时以 Resp 零值完成。
+// 处理器 panic 时总是恢复，错误经 ReportError 上报并完成 Future；统计见 runtime.ConcurrentContext.MessageStats。
+func Ask[Req, Resp any](entity ec.ConcurrentEntity, req Req) async.Future {
+	id, incarnation, err := checkCallingEntity(entity)
+	if err != nil {
+		return async.Rejected(err)
+	}
+
+	handler, counters, err := lookupMessageHandler[Req](entity)
+	if err != nil {
+		return async.Rejected(err)
+	}
+
+	respRT := reflect.TypeFor[Resp]()
+	if handler.Handler.ResponseRT != nil && !handler.Handler.ResponseRT.AssignableTo(respRT) {
+		return async.Rejected(fmt.Errorf("%w: message %q response %q is not assignable to %q", ErrMessageTypeMismatch, handler.Handler.MessageRT, handler.Handler.ResponseRT, respRT))
+	}
+
+	future := runtime.Concurrent(entity).Submit(func(ctx runtime.Context, _ ...any) async.Result {
+		resp, err := dispatchMessage(ctx, entity, id, incarnation, handler, counters, req)
+		if err != nil {
+			return async.NewResult(nil, err)
+		}
+		if resp == nil {
+			var zero Resp
+			return async.NewResult(zero, nil)
+		}
+		return async.NewResult(resp, nil)
+	})
+	if ret, ok := future.TryGet(); !ok || !(errors.Is(ret.Error, ErrTaskQueueClosed) || errors.Is(ret.Error, ErrTaskQueueFull)) {
+		counters.Asked.Add(1)
+	}
+
+	return future
+}
+
+// Tell 将 msg 经实体所属 Runtime 的邮箱投递给实体原型中处理 Msg 的内建组件，不创建 Future。
+// 仅返回实体已失活、ErrUnknownMessage 与入队错误；处理器的响应值被丢弃，执行失败计入统计，panic 经 ReportError 上报。
+func Tell[Msg any](entity ec.ConcurrentEntity, msg Msg) error {
+	id, incarnation, err := checkCallingEntity(entity)
+	if err != nil {
+		return err
+	}
+
+	handler, counters, err := lookupMessageHandler[Msg](entity)
+	if err != nil {
+		return err
+	}
+
+	err = runtime.Concurrent(entity).Post(func(ctx runtime.Context, _ ...any) {
+		dispatchMessage(ctx, entity, id, incarnation, handler, counters, msg)
+	})
+	if err != nil {
+		return err
+	}
+	counters.Told.Add(1)
+
+	return nil
+}
+
+func lookupMessageHandler[Msg any](entity ec.ConcurrentEntity) (ec.EntityMessageHandler, *runtime.MessageCounters, error) {
+	messageRT := reflect.TypeFor[Msg]()
+	counters := runtime.Concurrent(entity).MessageStats().Counters(messageRT)
+
+	handler, ok := entity.PT().MessageHandler(messageRT)
+	if !ok {
+		counters.Unknown.Add(1)
+		return ec.EntityMessageHandler{}, nil, fmt.Errorf("%w: entity %q prototype %q has no handler for message %q", ErrUnknownMessage, entity.ID(), entity.PT().Prototype(), messageRT)
+	}
+
+	return handler, counters, nil
+}
+
+func dispatchMessage(ctx runtime.Context, entity ec.ConcurrentEntity, id uid.ID, incarnation uint64, handler ec.EntityMessageHandler, counters *runtime.MessageCounters, msg any) (any, error) {
+	comp, err := lookupCallingComponent[ec.Component](entity, id, incarnation, handler.Component.Name)
+	if err == nil && comp.Reflected().Type() != handler.Handler.Method.Type.In(0) {
+		err = fmt.Errorf("%w: entity %q component %q is %s, not %s", ErrComponentTypeMismatch, id, handler.Component.Name, comp.Reflected().Type(), handler.Handler.Method.Type.In(0))
+	}
+	if err != nil {
+		counters.Failed.Add(1)
+		return nil, err
+	}
+
+	var resp any
+	panicErr := generic.CastAction0(func() {
+		resp, err = handler.Handler.Invoke(comp, msg)
+	}).Call(true, ctx.PanicReporter())
+	if panicErr != nil {
+		counters.Panicked.Add(1)
+		return nil, fmt.Errorf("%w: %w", ErrPanicked, panicErr)
+	}
+	if err != nil {
+		counters.Failed.Add(1)
+		return nil, err
+	}
+
+	counters.Handled.Add(1)
+	return resp, nil
+}
@@ -20,6 +20,7 @@
 package core
 
 import (
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -59,6 +60,10 @@ type Runtime interface {
 	iRuntime
 	iWorker
 	iRuntimeStats
+	iRuntimeControl
+	iRuntimeFrameRate
+	iRuntimeFrameProfile
+	iRuntimeJournal
 	corectx.CurrentContextProvider
 	corectx.ConcurrentContextProvider
 	reinterpret.InstanceProvider
@@ -69,6 +74,9 @@ type iRuntime interface {
 	init(rtCtx runtime.Context, options RuntimeOptions)
 	getOptions() *RuntimeOptions
 	getInstance() Runtime
+	getTimerService() *_TimerService
+	getTaskQueue() *_TaskQueue
+	observeComponent(comp ec.Component)
 }
 
 // RuntimeBehavior 提供 Runtime 的默认实现。
@@ -80,6 +88,7 @@ type RuntimeBehavior struct {
 	frame                                                *_Frame
 	taskQueue                                            _TaskQueue
 	handleEventEntityManagerAddEntity                    runtime.EventEntityManagerAddEntity
+	handleEventEntityManagerAddEntities                  runtime.EventEntityManagerAddEntities
 	handleEventEntityManagerRemoveEntity                 runtime.EventEntityManagerRemoveEntity
 	handleEventEntityManagerEntityAddComponents          runtime.EventEntityManagerEntityAddComponents
 	handleEventEntityManagerEntityRemoveComponent        runtime.EventEntityManagerEntityRemoveComponent
@@ -87,6 +96,15 @@ type RuntimeBehavior struct {
 	handleEventEntityManagerEntityFirstTouchComponent    runtime.EventEntityManagerEntityFirstTouchComponent
 	managedAddInManagerHandles                           [2]event.Handle
 	lastProgressTime                                     atomic.Int64
+	timers                                               _TimerService
+	paused                                               atomic.Bool
+	pendingSteps                                         atomic.Int64
+	control                                              chan struct{}
+	frameRate                                            chan float64
+	profiler                                             _FrameProfiler
+	journal                                              *LifecycleJournal
+	taskSeq                                              int64
+	curTaskID                                            int64
 
 	runtimeEventTab runtimeEventTab
 }
@@ -118,6 +136,7 @@ func (rt *RuntimeBehavior) init(rtCtx runtime.Context, options RuntimeOptions) {
 	rt.ctx = rtCtx
 	rt.options = options
 	rt.lastProgressTime.Store(time.Now().UnixNano())
+	rt.control = make(chan struct{}, 1)
 
 	if rt.options.InstanceFace.IsNil() {
 		rt.options.InstanceFace = iface.NewFaceT[Runtime](rt)
@@ -125,18 +144,27 @@ func (rt *RuntimeBehavior) init(rtCtx runtime.Context, options RuntimeOptions) {
 
 	if rt.options.Frame.Enabled {
 		rt.frame = &_Frame{}
+		rt.frameRate = make(chan float64, 1)
+		rt.profiler.init(rt.options.Frame)
 		rt.frame.init(rt.options.Frame.TargetFPS, rt.options.Frame.TotalFrames)
 		runtime.UnsafeContext(rtCtx).SetFrame(rt.frame)
 	} else {
 		runtime.UnsafeContext(rtCtx).SetFrame(nil)
 	}
 
-	rt.taskQueue.init(rt.options.TaskQueue.Unbounded, rt.options.TaskQueue.Capacity)
+	if rt.options.JournalSize > 0 {
+		rt.journal = &LifecycleJournal{}
+		rt.journal.init(rt.options.JournalSize)
+	}
+
+	rt.taskQueue.init(rt.options.TaskQueue, rt.onTaskQueueWatermark)
+	rt.timers.init(rt, rt.options.TimerTick)
 	runtime.UnsafeContext(rtCtx).SetCaller(rt.getInstance())
 
-	rt.runtimeEventTab.SetPanicHandling(rtCtx.AutoRecover(), rtCtx.ReportError())
+	rt.runtimeEventTab.SetPanicHandling(rtCtx.AutoRecover(), rtCtx.PanicReporter())
 
 	rt.handleEventEntityManagerAddEntity = runtime.HandleEventEntityManagerAddEntity(rt.onEntityManagerAddEntity)
+	rt.handleEventEntityManagerAddEntities = runtime.HandleEventEntityManagerAddEntities(rt.onEntityManagerAddEntities)
 	rt.handleEventEntityManagerRemoveEntity = runtime.HandleEventEntityManagerRemoveEntity(rt.onEntityManagerRemoveEntity)
 	rt.handleEventEntityManagerEntityAddComponents = runtime.HandleEventEntityManagerEntityAddComponents(rt.onEntityManagerEntityAddComponents)
 	rt.handleEventEntityManagerEntityRemoveComponent = runtime.HandleEventEntityManagerEntityRemoveComponent(rt.onEntityManagerEntityRemoveComponent)
@@ -157,96 +185,146 @@ func (rt *RuntimeBehavior) getInstance() Runtime {
 	return rt.options.InstanceFace.Iface
 }
 
+func (rt *RuntimeBehavior) getTimerService() *_TimerService {
+	return &rt.timers
+}
+
+func (rt *RuntimeBehavior) getTaskQueue() *_TaskQueue {
+	return &rt.taskQueue
+}
+
 // onEntityManagerAddEntity 在实体加入 Runtime 管理器后推进其激活流程。
 func (rt *RuntimeBehavior) onEntityManagerAddEntity(entityManager runtime.EntityManager, entity ec.Entity) {
 	if entity.State() != ec.EntityState_Entered {
 		return
 	}
 
-	ec.UnsafeEntity(entity).SetState(ec.EntityState_Awaking)
+	rt.journalEntityManaged(entity, ec.EntityState_Born.String())
+	rt.setEntityState(entity, ec.EntityState_Awaking)
 
-	{
-		caller := newEntityLifecycleCaller(entity)
+	if !newEntityLifecycleCaller(entity).Call(func() {
+		rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivating, entity)
+	}) || !rt.awakeEntity(entity) || !rt.startEntity(entity) {
+		rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivationAborted, entity)
+		return
+	}
 
-		if !caller.Call(func() {
-			rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivating, entity)
-		}) {
-			rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivationAborted, entity)
-			return
+	rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivated, entity)
+}
+
+// onEntityManagerAddEntities 分阶段激活一批实体：全部实体完成 Awake 后再统一进入 Start。
+// 任一实体的激活被中止时，逆序销毁整批实体。
+func (rt *RuntimeBehavior) onEntityManagerAddEntities(entityManager runtime.EntityManager, entities []ec.Entity) {
+	entities = slices.DeleteFunc(slices.Clone(entities), func(entity ec.Entity) bool {
+		return entity.State() != ec.EntityState_Entered
+	})
+	if len(entities) <= 0 {
+		return
+	}
+
+	for _, entity := range entities {
+		rt.journalEntityManaged(entity, ec.EntityState_Born.String())
+		rt.setEntityState(entity, ec.EntityState_Awaking)
+	}
+
+	rt.emitEventRunningEvent(runtime.RunningEvent_EntitiesActivating, entities)
+
+	activated := !slices.ContainsFunc(entities, func(entity ec.Entity) bool {
+		return entity.State() != ec.EntityState_Awaking
+	})
+
+	for i := 0; activated && i < len(entities); i++ {
+		activated = rt.awakeEntity(entities[i])
+	}
+
+	for i := 0; activated && i < len(entities); i++ {
+		activated = rt.startEntity(entities[i])
+	}
+
+	if !activated {
+		for i := len(entities) - 1; i >= 0; i-- {
+			entities[i].Destroy()
 		}
+		rt.emitEventRunningEvent(runtime.RunningEvent_EntitiesActivationAborted, entities)
+		return
+	}
 
-		if !caller.Call(func() {
-			if !caller.MarkProcessed() {
-				return
-			}
-			if cb, ok := entity.(LifecycleEntityAwake); ok {
-				rt.panicHandlingActivatingEntity(entity, generic.CastAction0(cb.Awake).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError()))
-			}
-		}) {
-			rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivationAborted, entity)
+	rt.emitEventRunningEvent(runtime.RunningEvent_EntitiesActivated, entities)
+}
+
+// awakeEntity 推进处于 Awaking 状态的实体及其组件执行 Awake 与首次启用；激活被中止时返回 false。
+func (rt *RuntimeBehavior) awakeEntity(entity ec.Entity) bool {
+	caller := newEntityLifecycleCaller(entity)
+
+	if !caller.Call(func() {
+		if !caller.MarkProcessed() {
 			return
 		}
+		if cb, ok := entity.(LifecycleEntityAwake); ok {
+			rt.panicHandlingActivatingEntity(entity, generic.CastAction0(cb.Awake).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter()))
+		}
+	}) {
+		return false
+	}
 
-		rt.observeEntity(entity)
+	rt.observeEntity(entity)
 
-		if !caller.Call(func() {
-			ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
-				comp := slot.V
-				return caller.Call(func() {
-					rt.panicHandlingActivatingEntity(entity, rt.awakeComponent(comp))
-				})
+	if !caller.Call(func() {
+		ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
+			comp := slot.V
+			return caller.Call(func() {
+				rt.panicHandlingActivatingEntity(entity, rt.awakeComponent(comp))
 			})
-		}) {
-			rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivationAborted, entity)
-			return
-		}
+		})
+	}) {
+		return false
+	}
 
-		if !caller.Call(func() {
-			ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
-				comp := slot.V
-				return caller.Call(func() {
-					rt.panicHandlingActivatingEntity(entity, rt.enableComponentAfterAwake(comp))
-				})
+	return caller.Call(func() {
+		ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
+			comp := slot.V
+			return caller.Call(func() {
+				rt.panicHandlingActivatingEntity(entity, rt.enableComponentAfterAwake(comp))
 			})
-		}) {
-			rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivationAborted, entity)
-			return
-		}
+		})
+	})
+}
+
+// startEntity 推进已完成 Awake 的实体及其组件执行 Start 并进入 Alive；激活被中止时返回 false。
+func (rt *RuntimeBehavior) startEntity(entity ec.Entity) bool {
+	if entity.State() != ec.EntityState_Awaking {
+		return false
 	}
 
-	ec.UnsafeEntity(entity).SetState(ec.EntityState_Starting)
+	rt.setEntityState(entity, ec.EntityState_Starting)
 
-	{
-		caller := newEntityLifecycleCaller(entity)
+	caller := newEntityLifecycleCaller(entity)
 
-		if !caller.Call(func() {
-			ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
-				comp := slot.V
-				return caller.Call(func() {
-					rt.panicHandlingActivatingEntity(entity, rt.startComponent(comp))
-				})
+	if !caller.Call(func() {
+		ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
+			comp := slot.V
+			return caller.Call(func() {
+				rt.panicHandlingActivatingEntity(entity, rt.startComponent(comp))
 			})
-		}) {
-			rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivationAborted, entity)
-			return
-		}
+		})
+	}) {
+		return false
+	}
 
-		if !caller.Call(func() {
-			if !caller.MarkProcessed() {
-				return
-			}
-			if cb, ok := entity.(LifecycleEntityStart); ok {
-				rt.panicHandlingActivatingEntity(entity, generic.CastAction0(cb.Start).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError()))
-			}
-		}) {
-			rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivationAborted, entity)
+	if !caller.Call(func() {
+		if !caller.MarkProcessed() {
 			return
 		}
+		if cb, ok := entity.(LifecycleEntityStart); ok {
+			rt.panicHandlingActivatingEntity(entity, generic.CastAction0(cb.Start).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter()))
+		}
+	}) {
+		return false
 	}
 
-	ec.UnsafeEntity(entity).SetState(ec.EntityState_Alive)
+	rt.setEntityState(entity, ec.EntityState_Alive)
 
-	rt.emitEventRunningEvent(runtime.RunningEvent_EntityActivated, entity)
+	return true
 }
 
 // onEntityManagerRemoveEntity 在实体离开 Runtime 管理器时推进其停用与销毁流程。
@@ -255,14 +333,16 @@ func (rt *RuntimeBehavior) onEntityManagerRemoveEntity(entityManager runtime.Ent
 		return
 	}
 
-	ec.UnsafeEntity(entity).SetState(ec.EntityState_Shutting)
+	rt.journalEntityManaged(entity, "")
+
+	rt.setEntityState(entity, ec.EntityState_Shutting)
 
 	ec.UnsafeEntity(entity).ComponentList().TraversalEach(func(slot *generic.FreeSlot[ec.Component]) {
 		comp := slot.V
 		if comp.State() < ec.ComponentState_Awaking {
 			return
 		}
-		ec.UnsafeComponent(comp).SetState(ec.ComponentState_Shutting)
+		rt.setComponentState(comp, ec.ComponentState_Shutting)
 	})
 
 	rt.emitEventRunningEvent(runtime.RunningEvent_EntityDeactivating, entity)
@@ -272,17 +352,19 @@ func (rt *RuntimeBehavior) onEntityManagerRemoveEntity(entityManager runtime.Ent
 
 		if caller.IsProcessed(ec.EntityState_Starting) && caller.MarkProcessed() {
 			if cb, ok := entity.(LifecycleEntityShut); ok {
-				generic.CastAction0(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+				generic.CastAction0(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 			}
 		}
 
+		rt.timers.stopOwner(entity)
+
 		ec.UnsafeEntity(entity).ComponentList().ReversedTraversalEach(func(slot *generic.FreeSlot[ec.Component]) {
 			comp := slot.V
 			rt.shutComponent(comp)
 		})
 	}
 
-	ec.UnsafeEntity(entity).SetState(ec.EntityState_Dead)
+	rt.setEntityState(entity, ec.EntityState_Dead)
 
 	{
 		caller := newEntityLifecycleCaller(entity)
@@ -299,16 +381,22 @@ func (rt *RuntimeBehavior) onEntityManagerRemoveEntity(entityManager runtime.Ent
 
 		if caller.IsProcessed(ec.EntityState_Awaking) && caller.MarkProcessed() {
 			if cb, ok := entity.(LifecycleEntityDispose); ok {
-				generic.CastAction0(cb.Dispose).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+				generic.CastAction0(cb.Dispose).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 			}
 		}
 	}
 
 	rt.emitEventRunningEvent(runtime.RunningEvent_EntityDeactivated, entity)
+
+	if entity.PT().PoolSize() > 0 {
+		rt.postRecycleEntity(entity)
+	}
 }
 
 // onEntityManagerEntityAddComponents 为运行中实体推进新增组件的激活流程。
 func (rt *RuntimeBehavior) onEntityManagerEntityAddComponents(entityManager runtime.EntityManager, entity ec.Entity, components []ec.Component) {
+	rt.journalComponents(JournalKind_AddComponent, entity, components...)
+
 	if entity.State() < ec.EntityState_Awaking || entity.State() > ec.EntityState_Alive {
 		return
 	}
@@ -370,6 +458,8 @@ func (rt *RuntimeBehavior) onEntityManagerEntityAddComponents(entityManager runt
 
 // onEntityManagerEntityRemoveComponent 为运行中实体推进组件移除流程。
 func (rt *RuntimeBehavior) onEntityManagerEntityRemoveComponent(entityManager runtime.EntityManager, entity ec.Entity, component ec.Component) {
+	rt.journalComponents(JournalKind_RemoveComponent, entity, component)
+
 	if entity.State() < ec.EntityState_Awaking || entity.State() > ec.EntityState_Alive {
 		return
 	}
@@ -388,7 +478,7 @@ func (rt *RuntimeBehavior) onEntityManagerEntityRemoveComponent(entityManager ru
 			return
 		}
 
-		ec.UnsafeComponent(component).SetState(ec.ComponentState_Shutting)
+		rt.setComponentState(component, ec.ComponentState_Shutting)
 
 		if !caller.Call(func() {
 			rt.shutComponent(component)
@@ -476,14 +566,32 @@ func (rt *RuntimeBehavior) observeEntity(entity ec.Entity) {
 }
 
 func (rt *RuntimeBehavior) observeComponent(comp ec.Component) {
-	if cb, ok := comp.(LifecycleComponentUpdate); ok {
-		ec.UnsafeComponent(comp).ManagedRuntimeUpdateHandle(_BindEventUpdate(&rt.runtimeEventTab, cb))
+	if ec.UnsafeComponent(comp).UpdateSuspended() {
+		return
+	}
+	update, lateUpdate := rt.profileComponent(comp)
+	update, lateUpdate = rt.throttleComponent(comp, update, lateUpdate)
+	if update == nil && lateUpdate == nil {
+		return
 	}
-	if cb, ok := comp.(LifecycleComponentLateUpdate); ok {
-		ec.UnsafeComponent(comp).ManagedRuntimeLateUpdateHandle(_BindEventLateUpdate(&rt.runtimeEventTab, cb))
+	order := rt.updateOrder(comp)
+	if update != nil {
+		ec.UnsafeComponent(comp).ManagedRuntimeUpdateHandle(_BindEventUpdate(&rt.runtimeEventTab, update, order))
+	}
+	if lateUpdate != nil {
+		ec.UnsafeComponent(comp).ManagedRuntimeLateUpdateHandle(_BindEventLateUpdate(&rt.runtimeEventTab, lateUpdate, order))
 	}
 }
 
+// updateOrder 返回组件的 Update 与 LateUpdate 执行顺序值；运行时选项中的覆盖值优先于组件原型声明。
+func (rt *RuntimeBehavior) updateOrder(comp ec.Component) int32 {
+	compPT := comp.Builtin().PT
+	if order, ok := rt.options.UpdateOrders[compPT.Prototype()]; ok {
+		return order
+	}
+	return compPT.UpdateOrder()
+}
+
 func (rt *RuntimeBehavior) unobserveComponent(comp ec.Component) {
 	ec.UnsafeComponent(comp).ManagedUnbindRuntimeHandles()
 }
@@ -493,7 +601,7 @@ func (rt *RuntimeBehavior) awakeComponent(comp ec.Component) (err error) {
 		return
 	}
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Awaking)
+	rt.setComponentState(comp, ec.ComponentState_Awaking)
 
 	{
 		caller := newComponentLifecycleCaller(comp)
@@ -503,14 +611,14 @@ func (rt *RuntimeBehavior) awakeComponent(comp ec.Component) (err error) {
 				return
 			}
 			if cb, ok := comp.(LifecycleComponentAwake); ok {
-				err = generic.CastAction0(cb.Awake).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+				err = generic.CastAction0(cb.Awake).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 			}
 		}) {
 			return
 		}
 	}
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Enabling)
+	rt.setComponentState(comp, ec.ComponentState_Enabling)
 
 	return
 }
@@ -521,7 +629,7 @@ func (rt *RuntimeBehavior) enableComponentAfterAwake(comp ec.Component) (err err
 	}
 
 	if !comp.Enabled() {
-		ec.UnsafeComponent(comp).SetState(ec.ComponentState_Idle)
+		rt.setComponentState(comp, ec.ComponentState_Idle)
 		return
 	}
 
@@ -533,7 +641,7 @@ func (rt *RuntimeBehavior) enableComponentAfterAwake(comp ec.Component) (err err
 				return
 			}
 			if cb, ok := comp.(LifecycleComponentOnEnable); ok {
-				err = generic.CastAction0(cb.OnEnable).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+				err = generic.CastAction0(cb.OnEnable).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 			}
 		}) {
 			return
@@ -541,13 +649,13 @@ func (rt *RuntimeBehavior) enableComponentAfterAwake(comp ec.Component) (err err
 	}
 
 	if !comp.Enabled() {
-		ec.UnsafeComponent(comp).SetState(ec.ComponentState_Idle)
+		rt.setComponentState(comp, ec.ComponentState_Idle)
 		return
 	}
 
 	rt.observeComponent(comp)
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Starting)
+	rt.setComponentState(comp, ec.ComponentState_Starting)
 
 	return
 }
@@ -565,14 +673,14 @@ func (rt *RuntimeBehavior) startComponent(comp ec.Component) (err error) {
 				return
 			}
 			if cb, ok := comp.(LifecycleComponentStart); ok {
-				err = generic.CastAction0(cb.Start).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+				err = generic.CastAction0(cb.Start).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 			}
 		}) {
 			return
 		}
 	}
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Alive)
+	rt.setComponentState(comp, ec.ComponentState_Alive)
 
 	return
 }
@@ -591,7 +699,7 @@ func (rt *RuntimeBehavior) shutComponent(comp ec.Component) {
 					return
 				}
 				if cb, ok := comp.(LifecycleComponentShut); ok {
-					generic.CastAction0(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+					generic.CastAction0(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 				}
 			}
 		}) {
@@ -599,7 +707,9 @@ func (rt *RuntimeBehavior) shutComponent(comp ec.Component) {
 		}
 	}
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Disabling)
+	rt.timers.stopOwner(comp)
+
+	rt.setComponentState(comp, ec.ComponentState_Disabling)
 }
 
 func (rt *RuntimeBehavior) disableDeathComponent(comp ec.Component) {
@@ -619,7 +729,7 @@ func (rt *RuntimeBehavior) disableDeathComponent(comp ec.Component) {
 					return
 				}
 				if cb, ok := comp.(LifecycleComponentOnDisable); ok {
-					generic.CastAction0(cb.OnDisable).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+					generic.CastAction0(cb.OnDisable).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 				}
 			}
 		}) {
@@ -627,7 +737,7 @@ func (rt *RuntimeBehavior) disableDeathComponent(comp ec.Component) {
 		}
 	}
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Dead)
+	rt.setComponentState(comp, ec.ComponentState_Dead)
 }
 
 func (rt *RuntimeBehavior) disposeComponent(comp ec.Component, stateDestroyed bool) {
@@ -644,7 +754,7 @@ func (rt *RuntimeBehavior) disposeComponent(comp ec.Component, stateDestroyed bo
 					return
 				}
 				if cb, ok := comp.(LifecycleComponentDispose); ok {
-					generic.CastAction0(cb.Dispose).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+					generic.CastAction0(cb.Dispose).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 				}
 			}
 		}) {
@@ -653,7 +763,7 @@ func (rt *RuntimeBehavior) disposeComponent(comp ec.Component, stateDestroyed bo
 	}
 
 	if stateDestroyed {
-		ec.UnsafeComponent(comp).SetState(ec.ComponentState_Destroyed)
+		rt.setComponentState(comp, ec.ComponentState_Destroyed)
 	}
 }
 
@@ -662,6 +772,8 @@ func (rt *RuntimeBehavior) enableComponent(comp ec.Component) {
 		return
 	}
 
+	rt.timers.resumeOwner(comp)
+
 	{
 		caller := newComponentLifecycleCaller(comp)
 
@@ -669,7 +781,7 @@ func (rt *RuntimeBehavior) enableComponent(comp ec.Component) {
 			caller.SetProcessed(ec.ComponentState_Enabling)
 
 			if cb, ok := comp.(LifecycleComponentOnEnable); ok {
-				generic.CastAction0(cb.OnEnable).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+				generic.CastAction0(cb.OnEnable).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 			}
 		}) {
 			return
@@ -678,7 +790,7 @@ func (rt *RuntimeBehavior) enableComponent(comp ec.Component) {
 
 	rt.observeComponent(comp)
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Starting)
+	rt.setComponentState(comp, ec.ComponentState_Starting)
 }
 
 func (rt *RuntimeBehavior) disableComponent(comp ec.Component) {
@@ -686,11 +798,13 @@ func (rt *RuntimeBehavior) disableComponent(comp ec.Component) {
 		return
 	}
 
+	rt.timers.pauseOwner(comp)
+
 	{
 		caller := newComponentLifecycleCaller(comp)
 
 		if !caller.IsProcessed(ec.ComponentState_Enabling) {
-			ec.UnsafeComponent(comp).SetState(ec.ComponentState_Idle)
+			rt.setComponentState(comp, ec.ComponentState_Idle)
 			return
 		}
 
@@ -698,17 +812,20 @@ func (rt *RuntimeBehavior) disableComponent(comp ec.Component) {
 
 		if !caller.Call(func() {
 			if cb, ok := comp.(LifecycleComponentOnDisable); ok {
-				generic.CastAction0(cb.OnDisable).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError())
+				generic.CastAction0(cb.OnDisable).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter())
 			}
 		}) {
 			return
 		}
 	}
 
-	ec.UnsafeComponent(comp).SetState(ec.ComponentState_Idle)
+	rt.setComponentState(comp, ec.ComponentState_Idle)
 }
 
 func (rt *RuntimeBehavior) panicHandlingActivatingEntity(entity ec.Entity, err error) {
+	if err != nil {
+		rt.journalRecord(JournalKind_ActivationPanic, entity.ID(), "", "", "", err)
+	}
 	if err != nil && !rt.options.ContinueOnActivatingEntityPanic {
 		entity.Destroy()
 	}
@@ -20,6 +20,7 @@
 package runtime
 
 import (
+	"log/slog"
 	"reflect"
 
 	"git.golaxy.org/core/extension"
@@ -43,6 +44,8 @@ type AddInManager interface {
 
 type iAddInManager interface {
 	getListStatuses() []AddInStatus
+	setLogger(logger *slog.Logger)
+	getLogger() *slog.Logger
 }
 
 // NewAddInManager 创建一个空的运行时插件管理器。
@@ -57,6 +60,7 @@ type _AddInManager struct {
 	addInNameIndex map[string]int
 	addInIDIndex   map[uint64]int
 	addInList      generic.FreeList[*_AddInStatus]
+	logger         *slog.Logger
 
 	addInManagerEventTab
 }
@@ -161,6 +165,17 @@ func (mgr *_AddInManager) getListStatuses() []AddInStatus {
 	return statuses
 }
 
+func (mgr *_AddInManager) setLogger(logger *slog.Logger) {
+	mgr.logger = logger
+}
+
+func (mgr *_AddInManager) getLogger() *slog.Logger {
+	if mgr.logger != nil {
+		return mgr.logger
+	}
+	return slog.New(slog.DiscardHandler)
+}
+
 // uninstallIfVersion 卸载仍占用指定槽位版本的插件，避免旧状态误删复用后的槽位。
 func (mgr *_AddInManager) uninstallIfVersion(idx int, ver int64) {
 	slot := mgr.addInList.Get(idx)
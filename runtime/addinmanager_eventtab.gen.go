@@ -23,6 +23,7 @@ package runtime
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IAddInManagerEventTab interface {
@@ -40,9 +41,9 @@ var (
 
 type addInManagerEventTab [3]event.Event
 
-func (eventTab *addInManagerEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *addInManagerEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
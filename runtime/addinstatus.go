@@ -21,6 +21,7 @@ package runtime
 
 import (
 	"fmt"
+	"log/slog"
 	"reflect"
 
 	"git.golaxy.org/core/event"
@@ -82,6 +83,11 @@ func (s *_AddInStatus) State() extension.AddInState {
 	return s.state
 }
 
+// Logger 返回在运行时日志记录器上附带 addin 属性的日志记录器。
+func (s *_AddInStatus) Logger() *slog.Logger {
+	return s.mgr.getLogger().With("addin", s.name)
+}
+
 // String 实现 fmt.Stringer，返回包含 ID、名称和实例类型的 JSON 文本。
 func (s *_AddInStatus) String() string {
 	if s.stringer == "" {
@@ -20,6 +20,7 @@
 package runtime
 
 import (
+	"log/slog"
 	"reflect"
 	"sync/atomic"
 
@@ -78,6 +79,8 @@ type Context interface {
 	EntityManager() EntityManager
 	// EntityTree 返回当前运行时的实体树。
 	EntityTree() EntityTree
+	// EntityPool 返回当前运行时的实体池。
+	EntityPool() EntityPool
 	// Managed 返回随运行时上下文统一解绑的事件句柄集合。
 	Managed() *event.ManagedHandles
 
@@ -90,6 +93,7 @@ type iContext interface {
 	emitEventRunningEvent(runningEvent RunningEvent, args ...any)
 	setFrame(frame Frame)
 	setCaller(caller Caller)
+	getCaller() Caller
 	getServiceContext() service.Context
 	getAddInManager() AddInManager
 	getScoped() *atomic.Bool
@@ -105,6 +109,8 @@ type ContextBehavior struct {
 	reflected      reflect.Value
 	frame          Frame
 	entityManager  _EntityManager
+	entityPool     _EntityPool
+	messageStats   _MessageStatsTab
 	caller         Caller
 	scoped         atomic.Bool
 	gcList         []GC
@@ -147,6 +153,11 @@ func (ctx *ContextBehavior) EntityTree() EntityTree {
 	return &ctx.entityManager
 }
 
+// EntityPool 返回当前运行时的实体池。
+func (ctx *ContextBehavior) EntityPool() EntityPool {
+	return &ctx.entityPool
+}
+
 // Managed 返回随运行时上下文统一解绑的事件句柄集合。
 func (ctx *ContextBehavior) Managed() *event.ManagedHandles {
 	return &ctx.managed
@@ -216,17 +227,18 @@ func (ctx *ContextBehavior) init(svcCtx service.Context, options ContextOptions)
 		ctx.options.AddInManager = NewAddInManager()
 	}
 
-	corectx.UnsafeContext(&ctx.ContextBehavior).Init(ctx.options.Context, ctx.options.AutoRecover, ctx.options.ReportError)
+	corectx.UnsafeContext(&ctx.ContextBehavior).Init(ctx.options.Context, ctx.options.AutoRecover, ctx.options.ReportError, ctx.newLogger(svcCtx))
+	ctx.getAddInManager().setLogger(ctx.Logger())
 
 	ctx.svcCtx = svcCtx
 	ctx.reflected = reflect.ValueOf(ctx.getInstance())
-	ctx.contextRunningEventTab.SetPanicHandling(ctx.AutoRecover(), ctx.ReportError())
+	ctx.contextRunningEventTab.SetPanicHandling(ctx.AutoRecover(), ctx.PanicReporter())
 
 	ctx.entityManager.init(ctx.getInstance())
 
-	event.UnsafeEvent(ctx.getAddInManager().EventInstallAddIn()).Ctrl().SetPanicHandling(ctx.AutoRecover(), ctx.ReportError())
-	event.UnsafeEvent(ctx.getAddInManager().EventUninstallAddIn()).Ctrl().SetPanicHandling(ctx.AutoRecover(), ctx.ReportError())
-	event.UnsafeEvent(ctx.getAddInManager().EventAddInStateChanged()).Ctrl().SetPanicHandling(ctx.AutoRecover(), ctx.ReportError())
+	event.UnsafeEvent(ctx.getAddInManager().EventInstallAddIn()).Ctrl().SetPanicHandling(ctx.AutoRecover(), ctx.PanicReporter())
+	event.UnsafeEvent(ctx.getAddInManager().EventUninstallAddIn()).Ctrl().SetPanicHandling(ctx.AutoRecover(), ctx.PanicReporter())
+	event.UnsafeEvent(ctx.getAddInManager().EventAddInStateChanged()).Ctrl().SetPanicHandling(ctx.AutoRecover(), ctx.PanicReporter())
 
 	if ctx.options.RunningEventCB != nil {
 		BindEventContextRunningEvent(ctx, HandleEventContextRunningEvent(ctx.options.RunningEventCB))
@@ -234,6 +246,24 @@ func (ctx *ContextBehavior) init(svcCtx service.Context, options ContextOptions)
 	BindEventContextRunningEvent(ctx, HandleEventContextRunningEvent(ctx.entityManager.onContextRunningEvent))
 }
 
+// newLogger 派生运行时日志记录器；未设置 Logger 时沿用服务日志记录器及其 service 属性。
+func (ctx *ContextBehavior) newLogger(svcCtx service.Context) *slog.Logger {
+	logger := svcCtx.Logger()
+	if ctx.options.Logger != nil {
+		svcName := svcCtx.Name()
+		if svcName == "" {
+			svcName = svcCtx.ID().String()
+		}
+		logger = ctx.options.Logger.With("service", svcName)
+	}
+
+	name := ctx.options.Name
+	if name == "" {
+		name = ctx.options.PersistID.String()
+	}
+	return logger.With("runtime", name)
+}
+
 func (ctx *ContextBehavior) getOptions() *ContextOptions {
 	return &ctx.options
 }
@@ -256,6 +286,10 @@ func (ctx *ContextBehavior) setCaller(caller Caller) {
 	ctx.caller = caller
 }
 
+func (ctx *ContextBehavior) getCaller() Caller {
+	return ctx.caller
+}
+
 func (ctx *ContextBehavior) getServiceContext() service.Context {
 	return ctx.svcCtx
 }
@@ -25,6 +25,7 @@ import (
 	"git.golaxy.org/core/ec"
 	"git.golaxy.org/core/utils/async"
 	"git.golaxy.org/core/utils/generic"
+	"git.golaxy.org/core/utils/uid"
 )
 
 // Caller 将任务投递到 Runtime Actor 邮箱。
@@ -65,7 +66,12 @@ func (ctx *ContextBehavior) PostDelegate(fun generic.DelegateVoidVar1[Context, a
 	return ctx.caller.PostDelegate(fun, args...)
 }
 
-func checkEntity(entity ec.Entity) error {
+// checkEntity 检查实体仍处于可调用状态；incarnation 是投递调用时引用绑定的实体生命周期序号，
+// 用于识别引用过期或执行前已被实体池回收并复用的实体。
+func checkEntity(entity ec.Entity, id uid.ID, incarnation uint64) error {
+	if ec.UnsafeConcurrentEntity(entity).Incarnation() != incarnation {
+		return fmt.Errorf("%w: entity %q has been recycled", ErrContext, id)
+	}
 	if entity.State() > ec.EntityState_Alive {
 		return fmt.Errorf("%w: entity is in an unexpected state %q", ErrContext, entity.State())
 	}
@@ -73,9 +79,10 @@ func checkEntity(entity ec.Entity) error {
 }
 
 func submit(entity ec.ConcurrentEntity, fun generic.FuncVar1[ec.Entity, any, async.Result], args ...any) async.Future {
+	id, incarnation := entity.ID(), ec.UnsafeConcurrentEntity(entity).Incarnation()
 	return Concurrent(entity).Submit(func(_ Context, args ...any) async.Result {
 		entity := ec.UnsafeConcurrentEntity(entity).Instance()
-		if err := checkEntity(entity); err != nil {
+		if err := checkEntity(entity, id, incarnation); err != nil {
 			return async.NewResult(nil, err)
 		}
 		return fun.UnsafeCall(entity, args...)
@@ -83,9 +90,10 @@ func submit(entity ec.ConcurrentEntity, fun generic.FuncVar1[ec.Entity, any, asy
 }
 
 func submitDelegate(entity ec.ConcurrentEntity, fun generic.DelegateVar1[ec.Entity, any, async.Result], args ...any) async.Future {
+	id, incarnation := entity.ID(), ec.UnsafeConcurrentEntity(entity).Incarnation()
 	return Concurrent(entity).Submit(func(_ Context, args ...any) async.Result {
 		entity := ec.UnsafeConcurrentEntity(entity).Instance()
-		if err := checkEntity(entity); err != nil {
+		if err := checkEntity(entity, id, incarnation); err != nil {
 			return async.NewResult(nil, err)
 		}
 		return fun.UnsafeCall(nil, entity, args...)
@@ -93,9 +101,10 @@ func submitDelegate(entity ec.ConcurrentEntity, fun generic.DelegateVar1[ec.Enti
 }
 
 func submitVoid(entity ec.ConcurrentEntity, fun generic.ActionVar1[ec.Entity, any], args ...any) async.Future {
+	id, incarnation := entity.ID(), ec.UnsafeConcurrentEntity(entity).Incarnation()
 	return Concurrent(entity).Submit(func(_ Context, args ...any) async.Result {
 		entity := ec.UnsafeConcurrentEntity(entity).Instance()
-		if err := checkEntity(entity); err != nil {
+		if err := checkEntity(entity, id, incarnation); err != nil {
 			return async.NewResult(nil, err)
 		}
 		fun.UnsafeCall(entity, args...)
@@ -104,9 +113,10 @@ func submitVoid(entity ec.ConcurrentEntity, fun generic.ActionVar1[ec.Entity, an
 }
 
 func submitDelegateVoid(entity ec.ConcurrentEntity, fun generic.DelegateVoidVar1[ec.Entity, any], args ...any) async.Future {
+	id, incarnation := entity.ID(), ec.UnsafeConcurrentEntity(entity).Incarnation()
 	return Concurrent(entity).Submit(func(_ Context, args ...any) async.Result {
 		entity := ec.UnsafeConcurrentEntity(entity).Instance()
-		if err := checkEntity(entity); err != nil {
+		if err := checkEntity(entity, id, incarnation); err != nil {
 			return async.NewResult(nil, err)
 		}
 		fun.UnsafeCall(nil, entity, args...)
@@ -115,9 +125,10 @@ func submitDelegateVoid(entity ec.ConcurrentEntity, fun generic.DelegateVoidVar1
 }
 
 func post(entity ec.ConcurrentEntity, fun generic.ActionVar1[ec.Entity, any], args ...any) error {
+	id, incarnation := entity.ID(), ec.UnsafeConcurrentEntity(entity).Incarnation()
 	return Concurrent(entity).Post(func(_ Context, args ...any) {
 		entity := ec.UnsafeConcurrentEntity(entity).Instance()
-		if checkEntity(entity) != nil {
+		if checkEntity(entity, id, incarnation) != nil {
 			return
 		}
 		fun.UnsafeCall(entity, args...)
@@ -125,9 +136,10 @@ func post(entity ec.ConcurrentEntity, fun generic.ActionVar1[ec.Entity, any], ar
 }
 
 func postDelegate(entity ec.ConcurrentEntity, fun generic.DelegateVoidVar1[ec.Entity, any], args ...any) error {
+	id, incarnation := entity.ID(), ec.UnsafeConcurrentEntity(entity).Incarnation()
 	return Concurrent(entity).Post(func(_ Context, args ...any) {
 		entity := ec.UnsafeConcurrentEntity(entity).Instance()
-		if checkEntity(entity) != nil {
+		if checkEntity(entity, id, incarnation) != nil {
 			return
 		}
 		fun.UnsafeCall(nil, entity, args...)
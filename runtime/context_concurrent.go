@@ -52,6 +52,8 @@ type ConcurrentContext interface {
 	BlockedFutureID() async.FutureID
 	// LastWaitRejectID 返回最近一次被 Runtime 等待规则拒绝的 Future ID。
 	LastWaitRejectID() async.FutureID
+	// MessageStats 返回按消息类型统计的 Ask/Tell 计数表。
+	MessageStats() MessageStatsTab
 }
 
 type iConcurrentContext interface {
@@ -78,6 +80,11 @@ func (ctx *ContextBehavior) LastWaitRejectID() async.FutureID {
 	return async.FutureID(ctx.lastWaitReject.Load())
 }
 
+// MessageStats 返回按消息类型统计的 Ask/Tell 计数表。
+func (ctx *ContextBehavior) MessageStats() MessageStatsTab {
+	return &ctx.messageStats
+}
+
 // String 实现 fmt.Stringer，返回包含运行时 ID 和名称的 JSON 文本。
 func (ctx *ContextBehavior) String() string {
 	if cached := ctx.stringerCache.Load(); cached != nil {
@@ -23,6 +23,7 @@ package runtime
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IContextRunningEventTab interface {
@@ -36,9 +37,9 @@ var (
 
 type contextRunningEventTab [1]event.Event
 
-func (eventTab *contextRunningEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *contextRunningEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
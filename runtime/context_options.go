@@ -21,6 +21,7 @@ package runtime
 
 import (
 	"context"
+	"log/slog"
 
 	"git.golaxy.org/core/utils/generic"
 	"git.golaxy.org/core/utils/iface"
@@ -41,6 +42,7 @@ type ContextOptions struct {
 	Name           string              // 运行时名称。
 	PersistID      uid.ID              // 运行时持久化 ID；为 Nil 时自动生成。
 	AddInManager   AddInManager        // 运行时插件管理器；nil 时创建默认管理器。
+	Logger         *slog.Logger        // 结构化日志记录器；nil 时使用所属服务的日志记录器。
 	RunningEventCB RunningEventCB      // 运行时运行事件回调。
 }
 
@@ -58,6 +60,7 @@ func (_ContextOption) Default() option.Setting[ContextOptions] {
 		With.Name("").Apply(options)
 		With.PersistID(uid.Nil).Apply(options)
 		With.AddInManager(nil).Apply(options)
+		With.Logger(nil).Apply(options)
 		With.RunningEventCB(nil).Apply(options)
 	}
 }
@@ -105,6 +108,14 @@ func (_ContextOption) AddInManager(mgr AddInManager) option.Setting[ContextOptio
 	}
 }
 
+// Logger 设置结构化日志记录器。运行时上下文的日志附带 service 与 runtime 属性，runtime 的值为运行时名称，
+// 未命名时为运行时 ID；实体、组件与插件的日志记录器均由其派生。
+func (_ContextOption) Logger(logger *slog.Logger) option.Setting[ContextOptions] {
+	return func(options *ContextOptions) {
+		options.Logger = logger
+	}
+}
+
 // RunningEventCB 设置运行时运行事件回调。
 func (_ContextOption) RunningEventCB(cb RunningEventCB) option.Setting[ContextOptions] {
 	return func(options *ContextOptions) {
@@ -34,6 +34,8 @@ ErrRuntimeSelfWait，其他 pending Future 返回 ErrBlockingWaitInRuntime。应
 ContinueOn 表达非阻塞的 Actor 续体。
 
 用 NewContext 创建上下文后，再交给 core.NewRuntime 绑定和运行。实体实例通常在
-runtime.RunningEvent_Started 阶段通过 core.BuildEntity 创建。
+runtime.RunningEvent_Started 阶段通过 core.BuildEntity 创建，大批量创建时使用
+core.BuildEntityBatch 统一加入与激活。声明了 PoolSize 的原型在实体销毁后会被回收
+进运行时的 EntityPool，再由 core.BuildEntity 复用。
 */
 package runtime
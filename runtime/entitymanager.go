@@ -21,6 +21,7 @@ package runtime
 
 import (
 	"fmt"
+	"slices"
 
 	"git.golaxy.org/core/ec"
 	"git.golaxy.org/core/event"
@@ -39,6 +40,9 @@ type EntityManager interface {
 
 	// AddEntity 接管 Born 状态的实体；运行时已启动时会同步推进其生命周期。
 	AddEntity(entity ec.Entity) error
+	// AddEntities 接管一批 Born 状态的实体，全部登记成功后只派发一次批量加入事件；运行时已启动时会同步推进整批实体的生命周期。
+	// 任一实体校验或登记失败时，已完成的全局登记会被撤销，整批实体都不会加入管理器。
+	AddEntities(entities []ec.Entity) error
 	// RemoveEntity 按 ID 请求销毁实体；实体不存在时不执行任何操作。
 	RemoveEntity(id uid.ID)
 	// GetEntity 按 ID 查询本地实体。
@@ -94,15 +98,8 @@ func (mgr *_EntityManager) AddEntity(entity ec.Entity) error {
 		exception.Panicf("%w: %w: entity is nil", ErrEntityManager, exception.ErrArgs)
 	}
 
-	if entity.State() != ec.EntityState_Born {
-		return fmt.Errorf("%w: invalid entity %q state %q", ErrEntityManager, entity.ID(), entity.State())
-	}
-
-	switch entity.Scope() {
-	case ec.Scope_Local, ec.Scope_Global:
-		break
-	default:
-		return fmt.Errorf("%w: invalid entity %q scope %q", ErrEntityManager, entity.ID(), entity.Scope())
+	if err := checkAddingEntity(entity); err != nil {
+		return err
 	}
 
 	mgr.initEntity(entity)
@@ -113,27 +110,78 @@ func (mgr *_EntityManager) AddEntity(entity ec.Entity) error {
 	}
 
 	if entity.Scope() == ec.Scope_Global {
-		_, loaded, err := service.Current(mgr).EntityManager().GetOrAddEntity(entity)
-		if err != nil {
+		if err := mgr.registerGlobalEntity(entity); err != nil {
 			entity.AsyncScope().Close()
-			return fmt.Errorf("%w: entity %q add to service entity-manager failed, %w", ErrEntityManager, entity.ID(), err)
+			return err
 		}
-		if loaded {
+	}
+
+	mgr.enterEntity(entity)
+
+	_EmitEventEntityManagerAddEntity(mgr, mgr, entity)
+
+	return nil
+}
+
+// AddEntities 接管一批 Born 状态的实体，全部登记成功后只派发一次批量加入事件；运行时已启动时会同步推进整批实体的生命周期。
+// 任一实体校验或登记失败时，已完成的全局登记会被撤销，整批实体都不会加入管理器。
+func (mgr *_EntityManager) AddEntities(entities []ec.Entity) error {
+	if len(entities) <= 0 {
+		return fmt.Errorf("%w: %w: entities is empty", ErrEntityManager, exception.ErrArgs)
+	}
+
+	if slices.Contains(entities, nil) {
+		exception.Panicf("%w: %w: entities contains nil", ErrEntityManager, exception.ErrArgs)
+	}
+
+	for _, entity := range entities {
+		if err := checkAddingEntity(entity); err != nil {
+			return err
+		}
+	}
+
+	closeAll := func() {
+		for _, entity := range entities {
 			entity.AsyncScope().Close()
-			return fmt.Errorf("%w: entity %q already exists in service entity-manager", ErrEntityManager, entity.ID())
 		}
 	}
 
-	entitySlot := mgr.entityList.PushBack(entity)
-	mgr.entityIDIndex[entity.ID()] = entitySlot.Index()
+	ids := make(map[uid.ID]struct{}, len(entities))
 
-	ec.UnsafeEntity(entity).SetState(ec.EntityState_Entered)
-	ec.UnsafeEntity(entity).SetEnteredHandle(entitySlot.Index(), entitySlot.Version())
-	ec.UnsafeEntity(entity).SetTreeNodeState(ec.TreeNodeState_Free)
+	for _, entity := range entities {
+		mgr.initEntity(entity)
 
-	mgr.observeEntity(entity)
+		if _, ok := mgr.entityIDIndex[entity.ID()]; ok {
+			closeAll()
+			return fmt.Errorf("%w: entity %q already exists in entity-manager", ErrEntityManager, entity.ID())
+		}
+		if _, ok := ids[entity.ID()]; ok {
+			closeAll()
+			return fmt.Errorf("%w: entity %q is duplicated in the batch", ErrEntityManager, entity.ID())
+		}
+		ids[entity.ID()] = struct{}{}
+	}
 
-	_EmitEventEntityManagerAddEntity(mgr, mgr, entity)
+	for i, entity := range entities {
+		if entity.Scope() != ec.Scope_Global {
+			continue
+		}
+		if err := mgr.registerGlobalEntity(entity); err != nil {
+			for _, registered := range entities[:i] {
+				if registered.Scope() == ec.Scope_Global {
+					service.Current(mgr).EntityManager().RemoveEntity(registered.ID())
+				}
+			}
+			closeAll()
+			return err
+		}
+	}
+
+	for _, entity := range entities {
+		mgr.enterEntity(entity)
+	}
+
+	_EmitEventEntityManagerAddEntities(mgr, mgr, entities)
 
 	return nil
 }
@@ -245,8 +293,8 @@ func (mgr *_EntityManager) init(ctx Context) {
 	mgr.entityIDIndex = map[uid.ID]int{}
 	mgr.entityTreeNodes = map[int]*_TreeNode{forestNodeIdx: {parent: forestNodeIdx}}
 
-	mgr.entityManagerEventTab.SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	mgr.entityTreeEventTab.SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
+	mgr.entityManagerEventTab.SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	mgr.entityTreeEventTab.SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
 }
 
 func (mgr *_EntityManager) onContextRunningEvent(ctx Context, runningEvent RunningEvent, args ...any) {
@@ -265,24 +313,59 @@ func (mgr *_EntityManager) onContextRunningEvent(ctx Context, runningEvent Runni
 	}
 }
 
+func checkAddingEntity(entity ec.Entity) error {
+	if entity.State() != ec.EntityState_Born {
+		return fmt.Errorf("%w: invalid entity %q state %q", ErrEntityManager, entity.ID(), entity.State())
+	}
+
+	switch entity.Scope() {
+	case ec.Scope_Local, ec.Scope_Global:
+		return nil
+	default:
+		return fmt.Errorf("%w: invalid entity %q scope %q", ErrEntityManager, entity.ID(), entity.Scope())
+	}
+}
+
+func (mgr *_EntityManager) registerGlobalEntity(entity ec.Entity) error {
+	_, loaded, err := service.Current(mgr).EntityManager().GetOrAddEntity(entity)
+	if err != nil {
+		return fmt.Errorf("%w: entity %q add to service entity-manager failed, %w", ErrEntityManager, entity.ID(), err)
+	}
+	if loaded {
+		return fmt.Errorf("%w: entity %q already exists in service entity-manager", ErrEntityManager, entity.ID())
+	}
+	return nil
+}
+
+func (mgr *_EntityManager) enterEntity(entity ec.Entity) {
+	entitySlot := mgr.entityList.PushBack(entity)
+	mgr.entityIDIndex[entity.ID()] = entitySlot.Index()
+
+	ec.UnsafeEntity(entity).SetState(ec.EntityState_Entered)
+	ec.UnsafeEntity(entity).SetEnteredHandle(entitySlot.Index(), entitySlot.Version())
+	ec.UnsafeEntity(entity).SetTreeNodeState(ec.TreeNodeState_Free)
+
+	mgr.observeEntity(entity)
+}
+
 func (mgr *_EntityManager) initEntity(entity ec.Entity) {
 	if entity.ID().IsNil() {
 		ec.UnsafeEntity(entity).SetID(uid.New())
 	}
 	ec.UnsafeEntity(entity).SetContext(mgr.ctx)
 
-	event.UnsafeEvent(entity.EventEntityDestroy()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
+	event.UnsafeEvent(entity.EventEntityDestroy()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
 
-	event.UnsafeEvent(entity.EventComponentManagerAddComponents()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(entity.EventComponentManagerRemoveComponent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(entity.EventComponentManagerComponentEnableChanged()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(entity.EventComponentManagerFirstTouchComponent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
+	event.UnsafeEvent(entity.EventComponentManagerAddComponents()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(entity.EventComponentManagerRemoveComponent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(entity.EventComponentManagerComponentEnableChanged()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(entity.EventComponentManagerFirstTouchComponent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
 
-	event.UnsafeEvent(entity.EventTreeNodeAddChild()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(entity.EventTreeNodeRemoveChild()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(entity.EventTreeNodeAttachParent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(entity.EventTreeNodeDetachParent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(entity.EventTreeNodeMoveTo()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
+	event.UnsafeEvent(entity.EventTreeNodeAddChild()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(entity.EventTreeNodeRemoveChild()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(entity.EventTreeNodeAttachParent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(entity.EventTreeNodeDetachParent()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(entity.EventTreeNodeMoveTo()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
 
 	ec.UnsafeEntity(entity).ComponentList().TraversalEach(func(slot *generic.FreeSlot[ec.Component]) {
 		comp := slot.V
@@ -291,8 +374,8 @@ func (mgr *_EntityManager) initEntity(entity ec.Entity) {
 }
 
 func (mgr *_EntityManager) initComponent(entity ec.Entity, comp ec.Component) {
-	event.UnsafeEvent(comp.EventComponentEnableChanged()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
-	event.UnsafeEvent(comp.EventComponentDestroy()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.ReportError())
+	event.UnsafeEvent(comp.EventComponentEnableChanged()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
+	event.UnsafeEvent(comp.EventComponentDestroy()).Ctrl().SetPanicHandling(mgr.ctx.AutoRecover(), mgr.ctx.PanicReporter())
 
 	if ec.UnsafeEntity(entity).Options().ComponentUniqueID {
 		if comp.ID().IsNil() {
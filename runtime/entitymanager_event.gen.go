@@ -72,6 +72,52 @@ func (h EventEntityManagerAddEntityHandler) OnEntityManagerAddEntity(entityManag
 	h(entityManager, entity)
 }
 
+type iAutoEventEntityManagerAddEntities interface {
+	EventEntityManagerAddEntities() event.IEvent
+}
+
+func BindEventEntityManagerAddEntities(auto iAutoEventEntityManagerAddEntities, subscriber EventEntityManagerAddEntities, priority ...int32) event.Handle {
+	if auto == nil {
+		event.Panicf("%w: %w: auto is nil", event.ErrEvent, event.ErrArgs)
+	}
+	return event.Bind[EventEntityManagerAddEntities](auto.EventEntityManagerAddEntities(), subscriber, priority...)
+}
+
+func _EmitEventEntityManagerAddEntities(auto iAutoEventEntityManagerAddEntities, entityManager EntityManager, entities []ec.Entity) {
+	if auto == nil {
+		event.Panicf("%w: %w: auto is nil", event.ErrEvent, event.ErrArgs)
+	}
+	event.UnsafeEvent(auto.EventEntityManagerAddEntities()).Emit(func(subscriber event.Cache) bool {
+		event.Cache2Iface[EventEntityManagerAddEntities](subscriber).OnEntityManagerAddEntities(entityManager, entities)
+		return true
+	})
+}
+
+func _EmitEventEntityManagerAddEntitiesWithInterrupt(auto iAutoEventEntityManagerAddEntities, interrupt func(entityManager EntityManager, entities []ec.Entity) bool, entityManager EntityManager, entities []ec.Entity) {
+	if auto == nil {
+		event.Panicf("%w: %w: auto is nil", event.ErrEvent, event.ErrArgs)
+	}
+	event.UnsafeEvent(auto.EventEntityManagerAddEntities()).Emit(func(subscriber event.Cache) bool {
+		if interrupt != nil {
+			if interrupt(entityManager, entities) {
+				return false
+			}
+		}
+		event.Cache2Iface[EventEntityManagerAddEntities](subscriber).OnEntityManagerAddEntities(entityManager, entities)
+		return true
+	})
+}
+
+func HandleEventEntityManagerAddEntities(fun func(entityManager EntityManager, entities []ec.Entity)) EventEntityManagerAddEntitiesHandler {
+	return EventEntityManagerAddEntitiesHandler(fun)
+}
+
+type EventEntityManagerAddEntitiesHandler func(entityManager EntityManager, entities []ec.Entity)
+
+func (h EventEntityManagerAddEntitiesHandler) OnEntityManagerAddEntities(entityManager EntityManager, entities []ec.Entity) {
+	h(entityManager, entities)
+}
+
 type iAutoEventEntityManagerRemoveEntity interface {
 	EventEntityManagerRemoveEntity() event.IEvent
 }
@@ -30,6 +30,14 @@ type EventEntityManagerAddEntity interface {
 	OnEntityManagerAddEntity(entityManager EntityManager, entity ec.Entity)
 }
 
+// EventEntityManagerAddEntities 在一批实体全部写入本地索引并进入 Entered 后派发一次。
+// 批内实体不会再单独派发 EventEntityManagerAddEntity。
+// +event-gen:export_emit=0
+// +event-tab-gen:recursion=allow
+type EventEntityManagerAddEntities interface {
+	OnEntityManagerAddEntities(entityManager EntityManager, entities []ec.Entity)
+}
+
 // EventEntityManagerRemoveEntity 在实体进入 Leaving 且树关系已移除、但本地与全局索引尚未删除时派发。
 // +event-gen:export_emit=0
 // +event-tab-gen:recursion=allow
@@ -23,10 +23,12 @@ package runtime
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IEntityManagerEventTab interface {
 	EventEntityManagerAddEntity() event.IEvent
+	EventEntityManagerAddEntities() event.IEvent
 	EventEntityManagerRemoveEntity() event.IEvent
 	EventEntityManagerEntityAddComponents() event.IEvent
 	EventEntityManagerEntityRemoveComponent() event.IEvent
@@ -37,18 +39,19 @@ type IEntityManagerEventTab interface {
 var (
 	_entityManagerEventTabID                         = event.DeclareEventTabIDT[entityManagerEventTab]()
 	EventEntityManagerAddEntityID                    = event.DeclareEventIDT[entityManagerEventTab](0)
-	EventEntityManagerRemoveEntityID                 = event.DeclareEventIDT[entityManagerEventTab](1)
-	EventEntityManagerEntityAddComponentsID          = event.DeclareEventIDT[entityManagerEventTab](2)
-	EventEntityManagerEntityRemoveComponentID        = event.DeclareEventIDT[entityManagerEventTab](3)
-	EventEntityManagerEntityComponentEnableChangedID = event.DeclareEventIDT[entityManagerEventTab](4)
-	EventEntityManagerEntityFirstTouchComponentID    = event.DeclareEventIDT[entityManagerEventTab](5)
+	EventEntityManagerAddEntitiesID                  = event.DeclareEventIDT[entityManagerEventTab](1)
+	EventEntityManagerRemoveEntityID                 = event.DeclareEventIDT[entityManagerEventTab](2)
+	EventEntityManagerEntityAddComponentsID          = event.DeclareEventIDT[entityManagerEventTab](3)
+	EventEntityManagerEntityRemoveComponentID        = event.DeclareEventIDT[entityManagerEventTab](4)
+	EventEntityManagerEntityComponentEnableChangedID = event.DeclareEventIDT[entityManagerEventTab](5)
+	EventEntityManagerEntityFirstTouchComponentID    = event.DeclareEventIDT[entityManagerEventTab](6)
 )
 
-type entityManagerEventTab [6]event.Event
+type entityManagerEventTab [7]event.Event
 
-func (eventTab *entityManagerEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *entityManagerEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
@@ -59,6 +62,7 @@ func (eventTab *entityManagerEventTab) SetRecursion(recursion event.EventRecursi
 	eventTab[3].SetRecursion(event.EventRecursion_Allow)
 	eventTab[4].SetRecursion(event.EventRecursion_Allow)
 	eventTab[5].SetRecursion(event.EventRecursion_Allow)
+	eventTab[6].SetRecursion(event.EventRecursion_Allow)
 }
 
 func (eventTab *entityManagerEventTab) SetEnabled(b bool) {
@@ -95,6 +99,8 @@ func (eventTab *entityManagerEventTab) Event(id uint64) event.IEvent {
 		eventTab[4].SetRecursion(event.EventRecursion_Allow)
 	case 5:
 		eventTab[5].SetRecursion(event.EventRecursion_Allow)
+	case 6:
+		eventTab[6].SetRecursion(event.EventRecursion_Allow)
 	}
 	return &eventTab[pos]
 }
@@ -104,27 +110,32 @@ func (eventTab *entityManagerEventTab) EventEntityManagerAddEntity() event.IEven
 	return &eventTab[0]
 }
 
-func (eventTab *entityManagerEventTab) EventEntityManagerRemoveEntity() event.IEvent {
+func (eventTab *entityManagerEventTab) EventEntityManagerAddEntities() event.IEvent {
 	eventTab.SetRecursion(event.EventRecursion_Allow)
 	return &eventTab[1]
 }
 
-func (eventTab *entityManagerEventTab) EventEntityManagerEntityAddComponents() event.IEvent {
+func (eventTab *entityManagerEventTab) EventEntityManagerRemoveEntity() event.IEvent {
 	eventTab.SetRecursion(event.EventRecursion_Allow)
 	return &eventTab[2]
 }
 
-func (eventTab *entityManagerEventTab) EventEntityManagerEntityRemoveComponent() event.IEvent {
+func (eventTab *entityManagerEventTab) EventEntityManagerEntityAddComponents() event.IEvent {
 	eventTab.SetRecursion(event.EventRecursion_Allow)
 	return &eventTab[3]
 }
 
-func (eventTab *entityManagerEventTab) EventEntityManagerEntityComponentEnableChanged() event.IEvent {
+func (eventTab *entityManagerEventTab) EventEntityManagerEntityRemoveComponent() event.IEvent {
 	eventTab.SetRecursion(event.EventRecursion_Allow)
 	return &eventTab[4]
 }
 
-func (eventTab *entityManagerEventTab) EventEntityManagerEntityFirstTouchComponent() event.IEvent {
+func (eventTab *entityManagerEventTab) EventEntityManagerEntityComponentEnableChanged() event.IEvent {
 	eventTab.SetRecursion(event.EventRecursion_Allow)
 	return &eventTab[5]
 }
+
+func (eventTab *entityManagerEventTab) EventEntityManagerEntityFirstTouchComponent() event.IEvent {
+	eventTab.SetRecursion(event.EventRecursion_Allow)
+	return &eventTab[6]
+}
@@ -0,0 +1,185 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package runtime
+
+import (
+	"slices"
+	"strings"
+
+	"git.golaxy.org/core/ec"
+)
+
+// EntityPool 按实体原型缓存已回收的实体与组件实例，供 BuildEntity 复用。
+// 只有 PoolSize 大于 0 的原型会被缓存；原型重新声明后，旧版本的缓存实体会被丢弃。
+// 该接口不提供并发保护，应在所属运行时 goroutine 中使用。
+type EntityPool interface {
+	// Get 取出 entityPT 的一个缓存实体及其按内建组件位置排列的组件；没有缓存时返回 false。
+	Get(entityPT ec.EntityPT) (ec.Entity, []ec.Component, bool)
+	// Acceptable 报告实体池当前是否接受 entityPT 的回收实体。
+	Acceptable(entityPT ec.EntityPT) bool
+	// Put 缓存已回收的实体及其组件；不被接受时丢弃并返回 false。
+	Put(entity ec.Entity, components []ec.Component) bool
+	// Discard 记录一次未放回实体池的回收。
+	Discard(entityPT ec.EntityPT)
+	// Clear 丢弃全部缓存实体，统计数据保持不变。
+	Clear()
+	// Stats 返回各原型实体池的统计快照，按原型名排序。
+	Stats() []EntityPoolStats
+}
+
+// EntityPoolStats 描述一个原型的实体池统计。
+type EntityPoolStats struct {
+	Prototype string // 实体原型名。
+	Version   int64  // 当前缓存对应的原型版本。
+	Capacity  int    // 缓存实体上限。
+	Idle      int    // 当前缓存的实体数。
+	Hits      int64  // 从实体池取得实体的次数。
+	Misses    int64  // 启用实体池但没有缓存可用的次数。
+	Recycled  int64  // 成功放回实体池的次数。
+	Discarded int64  // 因缓存已满、版本过期或仍有后台任务等原因丢弃的回收实体数。
+}
+
+type _PooledEntity struct {
+	entity     ec.Entity
+	components []ec.Component
+}
+
+type _EntityPoolBucket struct {
+	entityPT ec.EntityPT
+	idle     []_PooledEntity
+	stats    EntityPoolStats
+}
+
+type _EntityPool struct {
+	buckets map[string]*_EntityPoolBucket
+}
+
+// Get 取出 entityPT 的一个缓存实体及其按内建组件位置排列的组件；没有缓存时返回 false。
+func (pool *_EntityPool) Get(entityPT ec.EntityPT) (ec.Entity, []ec.Component, bool) {
+	bucket := pool.bucket(entityPT)
+	if bucket == nil {
+		return nil, nil, false
+	}
+
+	if len(bucket.idle) <= 0 {
+		bucket.stats.Misses++
+		return nil, nil, false
+	}
+
+	last := len(bucket.idle) - 1
+	pooled := bucket.idle[last]
+	bucket.idle[last] = _PooledEntity{}
+	bucket.idle = bucket.idle[:last]
+	bucket.stats.Hits++
+
+	return pooled.entity, pooled.components, true
+}
+
+// Acceptable 报告实体池当前是否接受 entityPT 的回收实体。
+func (pool *_EntityPool) Acceptable(entityPT ec.EntityPT) bool {
+	bucket := pool.bucket(entityPT)
+	return bucket != nil && len(bucket.idle) < entityPT.PoolSize()
+}
+
+// Put 缓存已回收的实体及其组件；不被接受时丢弃并返回 false。
+func (pool *_EntityPool) Put(entity ec.Entity, components []ec.Component) bool {
+	if entity == nil {
+		return false
+	}
+
+	entityPT := entity.PT()
+
+	if !pool.Acceptable(entityPT) {
+		pool.Discard(entityPT)
+		return false
+	}
+
+	bucket := pool.bucket(entityPT)
+	bucket.idle = append(bucket.idle, _PooledEntity{entity: entity, components: components})
+	bucket.stats.Recycled++
+
+	return true
+}
+
+// Discard 记录一次未放回实体池的回收。
+func (pool *_EntityPool) Discard(entityPT ec.EntityPT) {
+	bucket, ok := pool.buckets[entityPT.Prototype()]
+	if !ok {
+		return
+	}
+	bucket.stats.Discarded++
+}
+
+// Clear 丢弃全部缓存实体，统计数据保持不变。
+func (pool *_EntityPool) Clear() {
+	for _, bucket := range pool.buckets {
+		bucket.stats.Discarded += int64(len(bucket.idle))
+		clear(bucket.idle)
+		bucket.idle = bucket.idle[:0]
+	}
+}
+
+// Stats 返回各原型实体池的统计快照，按原型名排序。
+func (pool *_EntityPool) Stats() []EntityPoolStats {
+	stats := make([]EntityPoolStats, 0, len(pool.buckets))
+	for _, bucket := range pool.buckets {
+		s := bucket.stats
+		s.Version = bucket.entityPT.Version()
+		s.Capacity = bucket.entityPT.PoolSize()
+		s.Idle = len(bucket.idle)
+		stats = append(stats, s)
+	}
+	slices.SortFunc(stats, func(a, b EntityPoolStats) int {
+		return strings.Compare(a.Prototype, b.Prototype)
+	})
+	return stats
+}
+
+// bucket 返回 entityPT 的缓存桶；原型未启用实体池时返回 nil。遇到更新版本的原型时丢弃旧版本的缓存实体。
+func (pool *_EntityPool) bucket(entityPT ec.EntityPT) *_EntityPoolBucket {
+	if entityPT == nil || entityPT.PoolSize() <= 0 {
+		return nil
+	}
+
+	bucket, ok := pool.buckets[entityPT.Prototype()]
+	if !ok {
+		if pool.buckets == nil {
+			pool.buckets = map[string]*_EntityPoolBucket{}
+		}
+		bucket = &_EntityPoolBucket{
+			entityPT: entityPT,
+			stats:    EntityPoolStats{Prototype: entityPT.Prototype()},
+		}
+		pool.buckets[entityPT.Prototype()] = bucket
+		return bucket
+	}
+
+	if bucket.entityPT != entityPT {
+		if entityPT.Version() < bucket.entityPT.Version() {
+			return nil
+		}
+		bucket.stats.Discarded += int64(len(bucket.idle))
+		clear(bucket.idle)
+		bucket.idle = bucket.idle[:0]
+		bucket.entityPT = entityPT
+	}
+
+	return bucket
+}
@@ -23,6 +23,7 @@ package runtime
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type IEntityTreeEventTab interface {
@@ -40,9 +41,9 @@ var (
 
 type entityTreeEventTab [3]event.Event
 
-func (eventTab *entityTreeEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *entityTreeEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
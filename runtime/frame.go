@@ -25,8 +25,10 @@ import (
 
 // Frame 提供运行时帧循环的配置值和只读统计信息。
 type Frame interface {
-	// TargetFPS 返回目标 FPS。
+	// TargetFPS 返回当前生效的目标 FPS；启用自适应帧率时可能低于 ConfiguredFPS。
 	TargetFPS() float64
+	// ConfiguredFPS 返回构造时或通过 SetTargetFPS 配置的目标 FPS。
+	ConfiguredFPS() float64
 	// CurFPS 返回最近一个统计周期内的实际 FPS。
 	CurFPS() float64
 	// TotalFrames 返回最大运行帧数；0 表示不限制。
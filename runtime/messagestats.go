@@ -0,0 +1,95 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package runtime
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// MessageStatsTab 按消息类型记录 Ask/Tell 消息的投递与处理次数，可跨 goroutine 并发使用。
+type MessageStatsTab interface {
+	// Counters 返回 messageRT 的计数器，首次访问时创建。
+	Counters(messageRT reflect.Type) *MessageCounters
+	// Stats 返回各消息类型的统计快照，按类型名排序。
+	Stats() []MessageStats
+}
+
+// MessageCounters 是一种消息类型的并发安全计数器。
+type MessageCounters struct {
+	Asked    atomic.Int64 // 成功投递的 Ask 数。
+	Told     atomic.Int64 // 成功投递的 Tell 数。
+	Handled  atomic.Int64 // 处理器正常返回的次数。
+	Failed   atomic.Int64 // 处理器返回错误，或执行前实体、组件已失效的次数。
+	Panicked atomic.Int64 // 处理器 panic 的次数。
+	Unknown  atomic.Int64 // 因实体原型没有对应处理器而拒绝的次数。
+}
+
+// MessageStats 描述一种消息类型的统计快照。
+type MessageStats struct {
+	Type     string // 消息类型名。
+	Asked    int64  // 成功投递的 Ask 数。
+	Told     int64  // 成功投递的 Tell 数。
+	Handled  int64  // 处理器正常返回的次数。
+	Failed   int64  // 处理器返回错误，或执行前实体、组件已失效的次数。
+	Panicked int64  // 处理器 panic 的次数。
+	Unknown  int64  // 因实体原型没有对应处理器而拒绝的次数。
+}
+
+type _MessageStatsTab struct {
+	counters sync.Map
+}
+
+// Counters 返回 messageRT 的计数器，首次访问时创建。
+func (tab *_MessageStatsTab) Counters(messageRT reflect.Type) *MessageCounters {
+	if v, ok := tab.counters.Load(messageRT); ok {
+		return v.(*MessageCounters)
+	}
+	v, _ := tab.counters.LoadOrStore(messageRT, &MessageCounters{})
+	return v.(*MessageCounters)
+}
+
+// Stats 返回各消息类型的统计快照，按类型名排序。
+func (tab *_MessageStatsTab) Stats() []MessageStats {
+	var stats []MessageStats
+
+	tab.counters.Range(func(k, v any) bool {
+		counters := v.(*MessageCounters)
+		stats = append(stats, MessageStats{
+			Type:     k.(reflect.Type).String(),
+			Asked:    counters.Asked.Load(),
+			Told:     counters.Told.Load(),
+			Handled:  counters.Handled.Load(),
+			Failed:   counters.Failed.Load(),
+			Panicked: counters.Panicked.Load(),
+			Unknown:  counters.Unknown.Load(),
+		})
+		return true
+	})
+
+	slices.SortFunc(stats, func(a, b MessageStats) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	return stats
+}
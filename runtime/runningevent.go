@@ -53,4 +53,11 @@ const (
 	RunningEvent_EntityComponentDeactivating                            // 实体开始停用即将删除的组件。
 	RunningEvent_EntityComponentDeactivationAborted                     // 组件的停用回调流程被中止；组件删除仍会完成。
 	RunningEvent_EntityComponentDeactivated                             // 组件停用完成，随后将从实体删除。
+	RunningEvent_EntitiesActivating                                     // 一批实体开始激活，参数为实体切片；批内实体不再单独派发实体激活事件。
+	RunningEvent_EntitiesActivationAborted                              // 一批实体的激活被中止，批内实体已全部销毁。
+	RunningEvent_EntitiesActivated                                      // 一批实体激活完成。
+	RunningEvent_Paused                                                 // 运行时暂停执行任务与帧。
+	RunningEvent_Resumed                                                // 运行时从暂停中恢复。
+	RunningEvent_TargetFPSChanged                                       // 通过 SetTargetFPS 修改的目标 FPS 生效，参数为原 FPS 与新 FPS（float64）。
+	RunningEvent_TargetFPSAdapted                                       // 自适应调节修改了目标 FPS，参数为原 FPS 与新 FPS（float64）。
 )
@@ -37,11 +37,18 @@ func _() {
 	_ = x[RunningEvent_EntityComponentDeactivating-26]
 	_ = x[RunningEvent_EntityComponentDeactivationAborted-27]
 	_ = x[RunningEvent_EntityComponentDeactivated-28]
+	_ = x[RunningEvent_EntitiesActivating-29]
+	_ = x[RunningEvent_EntitiesActivationAborted-30]
+	_ = x[RunningEvent_EntitiesActivated-31]
+	_ = x[RunningEvent_Paused-32]
+	_ = x[RunningEvent_Resumed-33]
+	_ = x[RunningEvent_TargetFPSChanged-34]
+	_ = x[RunningEvent_TargetFPSAdapted-35]
 }
 
-const _RunningEvent_name = "RunningEvent_BirthRunningEvent_StartingRunningEvent_StartedRunningEvent_FrameLoopBeginRunningEvent_FrameUpdateBeginRunningEvent_FrameUpdateEndRunningEvent_FrameLoopEndRunningEvent_RunCallBeginRunningEvent_RunCallEndRunningEvent_RunGCBeginRunningEvent_RunGCEndRunningEvent_TerminatingRunningEvent_TerminatedRunningEvent_AddInActivatingRunningEvent_AddInActivationAbortedRunningEvent_AddInActivatedRunningEvent_AddInDeactivatingRunningEvent_AddInDeactivatedRunningEvent_EntityActivatingRunningEvent_EntityActivationAbortedRunningEvent_EntityActivatedRunningEvent_EntityDeactivatingRunningEvent_EntityDeactivatedRunningEvent_EntityComponentsActivatingRunningEvent_EntityComponentsActivationAbortedRunningEvent_EntityComponentsActivatedRunningEvent_EntityComponentDeactivatingRunningEvent_EntityComponentDeactivationAbortedRunningEvent_EntityComponentDeactivated"
+const _RunningEvent_name = "RunningEvent_BirthRunningEvent_StartingRunningEvent_StartedRunningEvent_FrameLoopBeginRunningEvent_FrameUpdateBeginRunningEvent_FrameUpdateEndRunningEvent_FrameLoopEndRunningEvent_RunCallBeginRunningEvent_RunCallEndRunningEvent_RunGCBeginRunningEvent_RunGCEndRunningEvent_TerminatingRunningEvent_TerminatedRunningEvent_AddInActivatingRunningEvent_AddInActivationAbortedRunningEvent_AddInActivatedRunningEvent_AddInDeactivatingRunningEvent_AddInDeactivatedRunningEvent_EntityActivatingRunningEvent_EntityActivationAbortedRunningEvent_EntityActivatedRunningEvent_EntityDeactivatingRunningEvent_EntityDeactivatedRunningEvent_EntityComponentsActivatingRunningEvent_EntityComponentsActivationAbortedRunningEvent_EntityComponentsActivatedRunningEvent_EntityComponentDeactivatingRunningEvent_EntityComponentDeactivationAbortedRunningEvent_EntityComponentDeactivatedRunningEvent_EntitiesActivatingRunningEvent_EntitiesActivationAbortedRunningEvent_EntitiesActivatedRunningEvent_PausedRunningEvent_ResumedRunningEvent_TargetFPSChangedRunningEvent_TargetFPSAdapted"
 
-var _RunningEvent_index = [...]uint16{0, 18, 39, 59, 86, 115, 142, 167, 192, 215, 238, 259, 283, 306, 334, 369, 396, 426, 455, 484, 520, 548, 579, 609, 648, 694, 732, 772, 819, 858}
+var _RunningEvent_index = [...]uint16{0, 18, 39, 59, 86, 115, 142, 167, 192, 215, 238, 259, 283, 306, 334, 369, 396, 426, 455, 484, 520, 548, 579, 609, 648, 694, 732, 772, 819, 858, 889, 927, 957, 976, 996, 1025, 1054}
 
 func (i RunningEvent) String() string {
 	idx := int(i) - 0
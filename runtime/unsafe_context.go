@@ -61,6 +61,11 @@ func (u _UnsafeContext) SetCaller(caller Caller) {
 	u.setCaller(caller)
 }
 
+// Caller 返回 Runtime 邮箱调用接口，即绑定该上下文的 Runtime 实例。
+func (u _UnsafeContext) Caller() Caller {
+	return u.getCaller()
+}
+
 // ServiceContext 返回所属服务上下文。
 func (u _UnsafeContext) ServiceContext() service.Context {
 	return u.getServiceContext()
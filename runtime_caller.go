@@ -26,25 +26,29 @@ import (
 )
 
 func (rt *RuntimeBehavior) Submit(fun generic.FuncVar1[runtime.Context, any, async.Result], args ...any) async.Future {
-	return rt.taskQueue.enqueueSubmit(rt.ctx.ExecutorID(), fun, nil, nil, nil, args)
+	return rt.taskQueue.enqueueSubmit(nil, rt.ctx.ExecutorID(), fun, nil, nil, nil, args)
 }
 
 func (rt *RuntimeBehavior) SubmitDelegate(fun generic.DelegateVar1[runtime.Context, any, async.Result], args ...any) async.Future {
-	return rt.taskQueue.enqueueSubmit(rt.ctx.ExecutorID(), nil, nil, fun, nil, args)
+	return rt.taskQueue.enqueueSubmit(nil, rt.ctx.ExecutorID(), nil, nil, fun, nil, args)
 }
 
 func (rt *RuntimeBehavior) SubmitVoid(fun generic.ActionVar1[runtime.Context, any], args ...any) async.Future {
-	return rt.taskQueue.enqueueSubmit(rt.ctx.ExecutorID(), nil, fun, nil, nil, args)
+	return rt.taskQueue.enqueueSubmit(nil, rt.ctx.ExecutorID(), nil, fun, nil, nil, args)
 }
 
 func (rt *RuntimeBehavior) SubmitDelegateVoid(fun generic.DelegateVoidVar1[runtime.Context, any], args ...any) async.Future {
-	return rt.taskQueue.enqueueSubmit(rt.ctx.ExecutorID(), nil, nil, nil, fun, args)
+	return rt.taskQueue.enqueueSubmit(nil, rt.ctx.ExecutorID(), nil, nil, nil, fun, args)
 }
 
 func (rt *RuntimeBehavior) Post(fun generic.ActionVar1[runtime.Context, any], args ...any) error {
-	return rt.taskQueue.enqueuePost(fun, nil, args)
+	return rt.taskQueue.enqueuePost(nil, fun, nil, args)
 }
 
 func (rt *RuntimeBehavior) PostDelegate(fun generic.DelegateVoidVar1[runtime.Context, any], args ...any) error {
-	return rt.taskQueue.enqueuePost(nil, fun, args)
+	return rt.taskQueue.enqueuePost(nil, nil, fun, args)
+}
+
+func (rt *RuntimeBehavior) onTaskQueueWatermark(high bool, depth int64) {
+	rt.options.TaskQueue.WatermarkCB.Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter(), rt.getInstance(), high, int(depth))
 }
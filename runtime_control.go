@@ -0,0 +1,138 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"git.golaxy.org/core/runtime"
+)
+
+type iRuntimeControl interface {
+	// Pause 请求暂停运行时：停止取出任务、推进帧与触发时间定时器，任务仍可入队。
+	// 运行时在下一次调度前进入暂停并发送 RunningEvent_Paused；已经暂停时返回 false。
+	Pause() bool
+	// Resume 请求从暂停中恢复，运行时恢复后发送 RunningEvent_Resumed；未暂停时返回 false。
+	Resume() bool
+	// Step 在暂停期间请求单步执行：启用帧循环时推进一帧，否则执行一个已入队任务；未暂停时返回 false。
+	Step() bool
+	// Paused 返回是否已请求暂停。
+	Paused() bool
+}
+
+// Pause 请求暂停运行时：停止取出任务、推进帧与触发时间定时器，任务仍可入队。
+// 运行时在下一次调度前进入暂停并发送 RunningEvent_Paused；已经暂停时返回 false。可在任意 goroutine 中调用。
+func (rt *RuntimeBehavior) Pause() bool {
+	if !rt.paused.CompareAndSwap(false, true) {
+		return false
+	}
+	rt.wakeControl()
+	return true
+}
+
+// Resume 请求从暂停中恢复，运行时恢复后发送 RunningEvent_Resumed；未暂停时返回 false。可在任意 goroutine 中调用。
+// 未执行的单步请求随恢复一并丢弃。
+func (rt *RuntimeBehavior) Resume() bool {
+	if !rt.paused.CompareAndSwap(true, false) {
+		return false
+	}
+	rt.pendingSteps.Store(0)
+	rt.wakeControl()
+	return true
+}
+
+// Step 在暂停期间请求单步执行：启用帧循环时推进一帧，否则执行一个已入队任务；未暂停时返回 false。
+// 多次调用累计执行；可在任意 goroutine 中调用。
+func (rt *RuntimeBehavior) Step() bool {
+	if !rt.paused.Load() {
+		return false
+	}
+	rt.pendingSteps.Add(1)
+	rt.wakeControl()
+	return true
+}
+
+// Paused 返回是否已请求暂停。
+func (rt *RuntimeBehavior) Paused() bool {
+	return rt.paused.Load()
+}
+
+func (rt *RuntimeBehavior) wakeControl() {
+	select {
+	case rt.control <- struct{}{}:
+	default:
+	}
+}
+
+// runPaused 在暂停期间处理单步请求，直到恢复；运行时上下文结束时返回 false。
+func (rt *RuntimeBehavior) runPaused() bool {
+	if rt.frame != nil {
+		rt.frame.pause()
+	}
+	rt.emitEventRunningEvent(runtime.RunningEvent_Paused)
+
+	for rt.paused.Load() {
+		var (
+			taskOut <-chan _Task
+			ready   <-chan struct{}
+		)
+		if rt.pendingSteps.Load() > 0 {
+			if rt.frame != nil {
+				rt.takeStep()
+				rt.frame.resume()
+				rt.frameLoop(rt.ctx)
+				rt.frame.pause()
+				continue
+			}
+			taskOut, ready = rt.taskQueue.out(), rt.taskQueue.ready()
+		}
+
+		select {
+		case task := <-taskOut:
+			rt.takeStep()
+			rt.runTask(task, true)
+
+		case <-ready:
+			if task, ok := rt.taskQueue.poll(); ok {
+				rt.takeStep()
+				rt.runTask(task, true)
+			}
+
+		case <-rt.control:
+
+		case <-rt.ctx.Done():
+			return false
+		}
+	}
+
+	if rt.frame != nil {
+		rt.frame.resume()
+	}
+	rt.emitEventRunningEvent(runtime.RunningEvent_Resumed)
+	return true
+}
+
+// takeStep 消耗一个单步请求；Resume 并发清零时不会减为负数。
+func (rt *RuntimeBehavior) takeStep() {
+	for {
+		n := rt.pendingSteps.Load()
+		if n <= 0 || rt.pendingSteps.CompareAndSwap(n, n-1) {
+			return
+		}
+	}
+}
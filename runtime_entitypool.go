@@ -0,0 +1,163 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/corectx"
+	"git.golaxy.org/core/utils/generic"
+)
+
+// postRecycleEntity 在实体完成销毁后将其回收进实体池。
+// 回收任务排在当前任务之后执行，此时实体已进入 Destroyed 状态。
+func (rt *RuntimeBehavior) postRecycleEntity(entity ec.Entity) {
+	pool := rt.ctx.EntityPool()
+
+	if !pool.Acceptable(entity.PT()) {
+		pool.Discard(entity.PT())
+		return
+	}
+
+	if err := rt.getInstance().Post(func(runtime.Context, ...any) {
+		rt.recycleEntity(entity)
+	}); err != nil {
+		pool.Discard(entity.PT())
+	}
+}
+
+// recycleEntity 重置已销毁的实体及其内建组件并放回实体池。
+// 实体或组件的后台任务尚未全部结束，或 Reset 发生 panic 时丢弃该实体。
+func (rt *RuntimeBehavior) recycleEntity(entity ec.Entity) {
+	pool := rt.ctx.EntityPool()
+	entityPT := entity.PT()
+
+	if entity.State() != ec.EntityState_Destroyed || !pool.Acceptable(entityPT) || !asyncScopeCompleted(entity) {
+		pool.Discard(entityPT)
+		return
+	}
+
+	components, completed := builtinComponents(entity, entityPT, ec.ComponentState_Destroyed)
+	if !completed {
+		pool.Discard(entityPT)
+		return
+	}
+
+	if !resetPooledEntity(rt.ctx, entity, components) {
+		pool.Discard(entityPT)
+		return
+	}
+
+	pool.Put(entity, components)
+}
+
+// releaseUnusedEntity 重置构造后未加入运行时的实体并放回实体池，例如元数据绑定或加入实体管理器失败时从实体池取出的实体。
+// 原型未启用实体池、实体池已满或 Reset 发生 panic 时丢弃该实体。
+func releaseUnusedEntity(ctx runtime.Context, entity ec.Entity) {
+	pool := ctx.EntityPool()
+	entityPT := entity.PT()
+
+	if entity.State() != ec.EntityState_Born || !pool.Acceptable(entityPT) {
+		pool.Discard(entityPT)
+		return
+	}
+
+	components, _ := builtinComponents(entity, entityPT, ec.ComponentState_Attached)
+
+	if !resetPooledEntity(ctx, entity, components) {
+		pool.Discard(entityPT)
+		return
+	}
+
+	pool.Put(entity, components)
+}
+
+// builtinComponents 按内建组件位置收集实体中处于 state 状态的内建组件；
+// 有组件的后台任务尚未全部结束时返回 false。
+func builtinComponents(entity ec.Entity, entityPT ec.EntityPT, state ec.ComponentState) ([]ec.Component, bool) {
+	components := make([]ec.Component, entityPT.CountComponents())
+
+	completed := true
+	ec.UnsafeEntity(entity).ComponentList().TraversalEach(func(slot *generic.FreeSlot[ec.Component]) {
+		comp := slot.V
+		if !asyncScopeCompleted(comp) {
+			completed = false
+			return
+		}
+		builtin := comp.Builtin()
+		if builtin.Offset < 0 || builtin.Offset >= len(components) || builtin.PT != entityPT.GetComponent(builtin.Offset).PT {
+			return
+		}
+		if comp.State() != state || components[builtin.Offset] != nil {
+			return
+		}
+		components[builtin.Offset] = comp
+	})
+
+	return components, completed
+}
+
+// resetPooledEntity 重置实体及其内建组件；Reset 发生 panic 时返回 false。
+func resetPooledEntity(ctx runtime.Context, entity ec.Entity, components []ec.Component) bool {
+	for _, comp := range components {
+		if comp == nil {
+			continue
+		}
+		if !resetComponent(ctx, comp) {
+			return false
+		}
+	}
+	return resetEntity(ctx, entity)
+}
+
+func resetEntity(ctx runtime.Context, entity ec.Entity) bool {
+	cb, ok := entity.(LifecycleEntityReset)
+	if !ok {
+		ec.UnsafeEntity(entity).Recycle(true)
+		return true
+	}
+
+	if err := generic.CastAction0(cb.Reset).Call(ctx.AutoRecover(), ctx.PanicReporter()); err != nil {
+		return false
+	}
+
+	ec.UnsafeEntity(entity).Recycle(false)
+	return true
+}
+
+func resetComponent(ctx runtime.Context, comp ec.Component) bool {
+	cb, ok := comp.(LifecycleComponentReset)
+	if !ok {
+		ec.UnsafeComponent(comp).Recycle(true)
+		return true
+	}
+
+	if err := generic.CastAction0(cb.Reset).Call(ctx.AutoRecover(), ctx.PanicReporter()); err != nil {
+		return false
+	}
+
+	ec.UnsafeComponent(comp).Recycle(false)
+	return true
+}
+
+func asyncScopeCompleted(provider corectx.AsyncScopeProvider) bool {
+	asyncScope := provider.AsyncScope()
+	return asyncScope == nil || asyncScope.Completion().Completed()
+}
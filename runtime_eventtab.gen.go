@@ -23,6 +23,7 @@ package core
 
 import (
 	event "git.golaxy.org/core/event"
+	"git.golaxy.org/core/utils/exception"
 )
 
 type iRuntimeEventTab interface {
@@ -38,9 +39,9 @@ var (
 
 type runtimeEventTab [2]event.Event
 
-func (eventTab *runtimeEventTab) SetPanicHandling(autoRecover bool, reportError chan error) {
+func (eventTab *runtimeEventTab) SetPanicHandling(autoRecover bool, reportPanic exception.PanicReporter) {
 	for i := range eventTab {
-		eventTab[i].SetPanicHandling(autoRecover, reportError)
+		eventTab[i].SetPanicHandling(autoRecover, reportPanic)
 	}
 }
 
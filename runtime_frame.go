@@ -20,11 +20,23 @@
 package core
 
 import (
+	"math"
+	"sync/atomic"
 	"time"
 )
 
+const (
+	frameOverrunRatio     = 1.25 // 帧循环耗时超过预算的该倍数时视为超时。
+	frameOverrunThreshold = 3    // 连续超时达到该帧数时降低目标 FPS。
+	frameRecoverStep      = 4    // 回升时每次增加当前目标 FPS 的 1/frameRecoverStep。
+)
+
 type _Frame struct {
 	targetFPS            float64
+	configuredFPS        float64
+	requestedFPS         atomic.Uint64
+	overrunFrames        int64
+	steadyFrames         int64
 	totalFrames          int64
 	curFPS               float64
 	curFrames            int64
@@ -36,13 +48,19 @@ type _Frame struct {
 	lastUpdateElapseTime time.Duration
 	statFPSBeginTime     time.Time
 	statFPSFrames        int64
+	pauseBeginTime       time.Time
 }
 
-// TargetFPS 返回目标 FPS。
+// TargetFPS 返回当前生效的目标 FPS；启用自适应帧率时可能低于 ConfiguredFPS。
 func (frame *_Frame) TargetFPS() float64 {
 	return frame.targetFPS
 }
 
+// ConfiguredFPS 返回构造时或通过 SetTargetFPS 配置的目标 FPS。
+func (frame *_Frame) ConfiguredFPS() float64 {
+	return frame.configuredFPS
+}
+
 // CurFPS 返回最近一个统计周期内的实际 FPS。
 func (frame *_Frame) CurFPS() float64 {
 	return frame.curFPS
@@ -90,9 +108,61 @@ func (frame *_Frame) LastUpdateElapseTime() time.Duration {
 
 func (frame *_Frame) init(targetFPS float64, totalFrames int64) {
 	frame.targetFPS = targetFPS
+	frame.configuredFPS = targetFPS
 	frame.totalFrames = totalFrames
 }
 
+// request 记录一个目标 FPS 修改请求，由运行时 goroutine 在下一帧开始时应用；可在任意 goroutine 中调用。
+func (frame *_Frame) request(fps float64) {
+	frame.requestedFPS.Store(math.Float64bits(fps))
+}
+
+// applyRequest 应用未处理的目标 FPS 修改请求，返回原目标 FPS 以及是否存在请求。
+func (frame *_Frame) applyRequest() (float64, bool) {
+	bits := frame.requestedFPS.Swap(0)
+	if bits == 0 {
+		return 0, false
+	}
+	old := frame.targetFPS
+	frame.targetFPS = math.Float64frombits(bits)
+	frame.configuredFPS = frame.targetFPS
+	frame.overrunFrames = 0
+	frame.steadyFrames = 0
+	return old, true
+}
+
+// adapt 根据上一帧循环耗时调节目标 FPS，返回原目标 FPS 以及是否发生调节。
+// 连续 frameOverrunThreshold 帧超出预算时按实际耗时降低目标 FPS，不低于 minFPS；
+// 低于配置值且约 1 秒内没有超时时，每次回升当前值的 1/frameRecoverStep，不超过配置值。
+func (frame *_Frame) adapt(minFPS float64) (float64, bool) {
+	old := frame.targetFPS
+	budget := float64(time.Second) / old
+	elapsed := float64(frame.lastLoopElapseTime)
+
+	if elapsed > budget*frameOverrunRatio {
+		frame.steadyFrames = 0
+		frame.overrunFrames++
+		if frame.overrunFrames < frameOverrunThreshold || old <= minFPS {
+			return old, false
+		}
+		frame.overrunFrames = 0
+		frame.targetFPS = max(minFPS, min(old-1, math.Floor(float64(time.Second)/elapsed)))
+		return old, true
+	}
+
+	frame.overrunFrames = 0
+	if old >= frame.configuredFPS {
+		return old, false
+	}
+	frame.steadyFrames++
+	if frame.steadyFrames < int64(old) {
+		return old, false
+	}
+	frame.steadyFrames = 0
+	frame.targetFPS = min(frame.configuredFPS, old+math.Ceil(old/frameRecoverStep))
+	return old, true
+}
+
 func (frame *_Frame) setCurFrames(v int64) {
 	frame.curFrames = v
 }
@@ -145,3 +215,14 @@ func (frame *_Frame) updateBegin() {
 func (frame *_Frame) updateEnd() {
 	frame.lastUpdateElapseTime = time.Now().Sub(frame.updateBeginTime)
 }
+
+func (frame *_Frame) pause() {
+	frame.pauseBeginTime = time.Now()
+}
+
+// resume 将暂停时长从当前帧与 FPS 统计周期中扣除，避免恢复后报告过大的帧耗时。
+func (frame *_Frame) resume() {
+	paused := time.Since(frame.pauseBeginTime)
+	frame.loopBeginTime = frame.loopBeginTime.Add(paused)
+	frame.statFPSBeginTime = frame.statFPSBeginTime.Add(paused)
+}
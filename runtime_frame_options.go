@@ -24,14 +24,25 @@ import (
 
 	"git.golaxy.org/core/runtime"
 	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/generic"
 	"git.golaxy.org/core/utils/option"
 )
 
+type (
+	FrameOverrunCB = generic.Action2[Runtime, FrameOverrun] // 帧更新阶段超出帧预算时的回调。
+)
+
 // FrameOptions 定义运行时帧循环的选项。
 type FrameOptions struct {
-	Enabled     bool    // 是否启用帧循环。
-	TargetFPS   float64 // 目标 FPS；设置时会四舍五入为整数值。
-	TotalFrames int64   // 最大运行帧数；0 表示不限制。
+	Enabled       bool           // 是否启用帧循环。
+	TargetFPS     float64        // 目标 FPS；设置时会四舍五入为整数值。
+	TotalFrames   int64          // 最大运行帧数；0 表示不限制。
+	Adaptive      bool           // 是否启用自适应帧率：帧循环耗时持续超出预算时降低目标 FPS，恢复后逐步回升。
+	MinFPS        float64        // 自适应调节允许的最低 FPS；设置时会四舍五入为整数值。
+	Profile       bool           // 是否按组件原型统计 Update 与 LateUpdate 的耗时。
+	ProfileTopN   int            // 超时报告中保留的组件原型数量。
+	ProfileWindow int            // 超时报告保留的最近超时帧数量。
+	OverrunCB     FrameOverrunCB // 帧更新阶段超出帧预算时的回调。
 }
 
 type _FrameOption struct{}
@@ -42,6 +53,12 @@ func (_FrameOption) Default() option.Setting[FrameOptions] {
 		With.Frame.Enabled(true).Apply(options)
 		With.Frame.TargetFPS(30).Apply(options)
 		With.Frame.TotalFrames(0).Apply(options)
+		With.Frame.Adaptive(false).Apply(options)
+		With.Frame.MinFPS(1).Apply(options)
+		With.Frame.Profile(false).Apply(options)
+		With.Frame.ProfileTopN(5).Apply(options)
+		With.Frame.ProfileWindow(32).Apply(options)
+		With.Frame.OverrunCB(nil).Apply(options)
 	}
 }
 
@@ -52,13 +69,14 @@ func (_FrameOption) Enabled(b bool) option.Setting[FrameOptions] {
 	}
 }
 
-// TargetFPS 设置目标 FPS；fps 必须大于 0，并会被四舍五入为整数值。
+// TargetFPS 设置目标 FPS；fps 会被四舍五入为整数值，结果必须不小于 1。
 func (_FrameOption) TargetFPS(fps float64) option.Setting[FrameOptions] {
 	return func(options *FrameOptions) {
-		if fps <= 0 {
-			exception.Panicf("%w: %w: TargetFPS must be greater than 0", runtime.ErrFrame, exception.ErrArgs)
+		fps = math.Round(fps)
+		if !(fps >= 1) {
+			exception.Panicf("%w: %w: TargetFPS must be at least 1 after rounding", runtime.ErrFrame, exception.ErrArgs)
 		}
-		options.TargetFPS = math.Round(fps)
+		options.TargetFPS = fps
 	}
 }
 
@@ -71,3 +89,55 @@ func (_FrameOption) TotalFrames(v int64) option.Setting[FrameOptions] {
 		options.TotalFrames = v
 	}
 }
+
+// Adaptive 设置是否启用自适应帧率。
+func (_FrameOption) Adaptive(b bool) option.Setting[FrameOptions] {
+	return func(options *FrameOptions) {
+		options.Adaptive = b
+	}
+}
+
+// MinFPS 设置自适应调节允许的最低 FPS；fps 必须大于 0，并会被四舍五入为整数值。
+func (_FrameOption) MinFPS(fps float64) option.Setting[FrameOptions] {
+	return func(options *FrameOptions) {
+		if fps <= 0 {
+			exception.Panicf("%w: %w: MinFPS must be greater than 0", runtime.ErrFrame, exception.ErrArgs)
+		}
+		options.MinFPS = math.Round(fps)
+	}
+}
+
+// Profile 设置是否按组件原型统计 Update 与 LateUpdate 的耗时。启用后超时帧会附带耗时最高的组件原型，
+// 每次调用 Update 或 LateUpdate 会额外读取两次时钟。
+func (_FrameOption) Profile(b bool) option.Setting[FrameOptions] {
+	return func(options *FrameOptions) {
+		options.Profile = b
+	}
+}
+
+// ProfileTopN 设置超时报告中保留的组件原型数量；n 必须大于 0。
+func (_FrameOption) ProfileTopN(n int) option.Setting[FrameOptions] {
+	return func(options *FrameOptions) {
+		if n <= 0 {
+			exception.Panicf("%w: %w: ProfileTopN must be greater than 0", runtime.ErrFrame, exception.ErrArgs)
+		}
+		options.ProfileTopN = n
+	}
+}
+
+// ProfileWindow 设置超时报告保留的最近超时帧数量；0 表示只计数不保留，负值会导致 panic。
+func (_FrameOption) ProfileWindow(n int) option.Setting[FrameOptions] {
+	return func(options *FrameOptions) {
+		if n < 0 {
+			exception.Panicf("%w: %w: ProfileWindow must be greater than or equal to 0", runtime.ErrFrame, exception.ErrArgs)
+		}
+		options.ProfileWindow = n
+	}
+}
+
+// OverrunCB 设置帧更新阶段超出帧预算时的回调，在 Runtime goroutine 中调用。帧预算为当时目标 FPS 对应的帧间隔。
+func (_FrameOption) OverrunCB(cb FrameOverrunCB) option.Setting[FrameOptions] {
+	return func(options *FrameOptions) {
+		options.OverrunCB = cb
+	}
+}
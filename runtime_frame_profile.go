@@ -0,0 +1,268 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"git.golaxy.org/core/ec"
+)
+
+// ComponentFrameCost 描述一个组件原型在帧更新阶段的耗时。
+type ComponentFrameCost struct {
+	Prototype  string        // 组件原型。
+	Update     time.Duration // Update 累计耗时。
+	LateUpdate time.Duration // LateUpdate 累计耗时。
+	Calls      int64         // Update 与 LateUpdate 的调用次数。
+}
+
+// Total 返回 Update 与 LateUpdate 的累计耗时。
+func (c ComponentFrameCost) Total() time.Duration {
+	return c.Update + c.LateUpdate
+}
+
+// FrameOverrun 描述一次更新阶段超出帧预算的帧。
+type FrameOverrun struct {
+	Frame   int64                // 帧序号，与该帧执行时的 Frame().CurFrames() 相同。
+	Budget  time.Duration        // 帧预算，即当时目标 FPS 对应的帧间隔。
+	Elapsed time.Duration        // 更新阶段耗时。
+	Top     []ComponentFrameCost // 该帧耗时最高的组件原型，按耗时降序排列；未启用 Profile 时为空。
+}
+
+// FrameOverrunReport 描述最近若干次超时帧的汇总。
+type FrameOverrunReport struct {
+	Overruns int64                // 累计超时帧数。
+	Recent   []FrameOverrun       // 最近的超时帧，按发生先后排列，最多 ProfileWindow 个。
+	Top      []ComponentFrameCost // Recent 中各帧的组件原型耗时按原型合并后耗时最高的前 ProfileTopN 个。
+}
+
+type iRuntimeFrameProfile interface {
+	// FrameOverruns 返回最近超时帧的汇总报告；未启用帧循环时返回空报告。
+	FrameOverruns() FrameOverrunReport
+}
+
+// FrameOverruns 返回最近超时帧的汇总报告；未启用帧循环时返回空报告。可在任意 goroutine 中调用。
+func (rt *RuntimeBehavior) FrameOverruns() FrameOverrunReport {
+	return rt.profiler.report()
+}
+
+// profileComponent 启用 Profile 时，将组件的 Update 与 LateUpdate 包装为按原型计时的订阅者。
+func (rt *RuntimeBehavior) profileComponent(comp ec.Component) (eventUpdate, eventLateUpdate) {
+	update, _ := comp.(LifecycleComponentUpdate)
+	lateUpdate, _ := comp.(LifecycleComponentLateUpdate)
+
+	if !rt.profiler.enabled || (update == nil && lateUpdate == nil) {
+		return update, lateUpdate
+	}
+
+	profiled := &_ProfiledComponent{
+		update:     update,
+		lateUpdate: lateUpdate,
+		cost:       rt.profiler.cost(comp.Builtin().PT.Prototype()),
+	}
+
+	var profiledUpdate eventUpdate
+	if update != nil {
+		profiledUpdate = profiled
+	}
+	var profiledLateUpdate eventLateUpdate
+	if lateUpdate != nil {
+		profiledLateUpdate = profiled
+	}
+	return profiledUpdate, profiledLateUpdate
+}
+
+// checkFrameBudget 在更新阶段结束后检查帧预算，超时时记录并调用 OverrunCB。
+func (rt *RuntimeBehavior) checkFrameBudget() {
+	budget := frameInterval(rt.frame.TargetFPS())
+	elapsed := rt.frame.LastUpdateElapseTime()
+	overran := elapsed > budget
+
+	top := rt.profiler.collect(overran)
+	if !overran {
+		return
+	}
+
+	overrun := FrameOverrun{
+		Frame:   rt.frame.CurFrames(),
+		Budget:  budget,
+		Elapsed: elapsed,
+		Top:     top,
+	}
+	rt.profiler.record(overrun)
+
+	rt.options.Frame.OverrunCB.Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter(), rt.getInstance(), overrun)
+}
+
+type _ProfiledComponent struct {
+	update     LifecycleComponentUpdate
+	lateUpdate LifecycleComponentLateUpdate
+	cost       *_ComponentFrameCost
+}
+
+func (p *_ProfiledComponent) Update() {
+	begin := time.Now()
+	p.update.Update()
+	p.cost.add(&p.cost.update, time.Since(begin))
+}
+
+func (p *_ProfiledComponent) LateUpdate() {
+	begin := time.Now()
+	p.lateUpdate.LateUpdate()
+	p.cost.add(&p.cost.lateUpdate, time.Since(begin))
+}
+
+// _ComponentFrameCost 累计一个组件原型在当前帧的耗时，仅在运行时 goroutine 中访问。
+type _ComponentFrameCost struct {
+	profiler   *_FrameProfiler
+	prototype  string
+	update     time.Duration
+	lateUpdate time.Duration
+	calls      int64
+	touched    bool
+}
+
+func (c *_ComponentFrameCost) add(phase *time.Duration, d time.Duration) {
+	if !c.touched {
+		c.touched = true
+		c.profiler.touched = append(c.profiler.touched, c)
+	}
+	*phase += d
+	c.calls++
+}
+
+type _FrameProfiler struct {
+	enabled  bool
+	topN     int
+	costs    map[string]*_ComponentFrameCost
+	touched  []*_ComponentFrameCost
+	mutex    sync.Mutex
+	recent   []FrameOverrun
+	head     int
+	overruns int64
+}
+
+func (p *_FrameProfiler) init(options FrameOptions) {
+	p.enabled = options.Profile
+	p.topN = options.ProfileTopN
+	p.recent = make([]FrameOverrun, 0, options.ProfileWindow)
+	if p.enabled {
+		p.costs = map[string]*_ComponentFrameCost{}
+	}
+}
+
+func (p *_FrameProfiler) cost(prototype string) *_ComponentFrameCost {
+	cost, ok := p.costs[prototype]
+	if !ok {
+		cost = &_ComponentFrameCost{profiler: p, prototype: prototype}
+		p.costs[prototype] = cost
+	}
+	return cost
+}
+
+// collect 结束当前帧的计时并清零；top 为 true 时返回耗时最高的前 topN 个组件原型。
+func (p *_FrameProfiler) collect(top bool) []ComponentFrameCost {
+	var costs []ComponentFrameCost
+	if top && len(p.touched) > 0 {
+		costs = make([]ComponentFrameCost, 0, len(p.touched))
+	}
+
+	for _, cost := range p.touched {
+		if costs != nil {
+			costs = append(costs, ComponentFrameCost{
+				Prototype:  cost.prototype,
+				Update:     cost.update,
+				LateUpdate: cost.lateUpdate,
+				Calls:      cost.calls,
+			})
+		}
+		cost.update = 0
+		cost.lateUpdate = 0
+		cost.calls = 0
+		cost.touched = false
+	}
+	clear(p.touched)
+	p.touched = p.touched[:0]
+
+	return topFrameCosts(costs, p.topN)
+}
+
+func (p *_FrameProfiler) record(overrun FrameOverrun) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.overruns++
+
+	if cap(p.recent) <= 0 {
+		return
+	}
+	if len(p.recent) < cap(p.recent) {
+		p.recent = append(p.recent, overrun)
+		return
+	}
+	p.recent[p.head] = overrun
+	p.head = (p.head + 1) % len(p.recent)
+}
+
+func (p *_FrameProfiler) report() FrameOverrunReport {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	report := FrameOverrunReport{
+		Overruns: p.overruns,
+		Recent:   make([]FrameOverrun, 0, len(p.recent)),
+	}
+	report.Recent = append(report.Recent, p.recent[p.head:]...)
+	report.Recent = append(report.Recent, p.recent[:p.head]...)
+
+	merged := map[string]int{}
+	var costs []ComponentFrameCost
+	for _, overrun := range report.Recent {
+		for _, cost := range overrun.Top {
+			if i, ok := merged[cost.Prototype]; ok {
+				costs[i].Update += cost.Update
+				costs[i].LateUpdate += cost.LateUpdate
+				costs[i].Calls += cost.Calls
+				continue
+			}
+			merged[cost.Prototype] = len(costs)
+			costs = append(costs, cost)
+		}
+	}
+	report.Top = topFrameCosts(costs, p.topN)
+
+	return report
+}
+
+func topFrameCosts(costs []ComponentFrameCost, n int) []ComponentFrameCost {
+	slices.SortFunc(costs, func(a, b ComponentFrameCost) int {
+		if c := cmp.Compare(b.Total(), a.Total()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Prototype, b.Prototype)
+	})
+	if len(costs) > n {
+		costs = slices.Clip(costs[:n])
+	}
+	return costs
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"math"
+
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/exception"
+)
+
+type iRuntimeFrameRate interface {
+	// SetTargetFPS 修改目标 FPS；fps 会被四舍五入为整数值，结果必须不小于 1。
+	// 新值在下一帧开始时生效，生效时发送 RunningEvent_TargetFPSChanged；未启用帧循环时返回 false。
+	SetTargetFPS(fps float64) bool
+}
+
+// SetTargetFPS 修改目标 FPS；fps 会被四舍五入为整数值，结果必须不小于 1。
+// 新值在下一帧开始时生效，生效时发送 RunningEvent_TargetFPSChanged；未启用帧循环时返回 false。
+// 启用自适应帧率时，新值同时作为回升的上限。可在任意 goroutine 中调用。
+func (rt *RuntimeBehavior) SetTargetFPS(fps float64) bool {
+	fps = math.Round(fps)
+	if !(fps >= 1) {
+		exception.Panicf("%w: %w: fps must be at least 1 after rounding", runtime.ErrFrame, exception.ErrArgs)
+	}
+	if rt.frame == nil {
+		return false
+	}
+	rt.frame.request(fps)
+	return true
+}
+
+// applyFrameRate 在帧开始前应用 SetTargetFPS 的修改请求。
+func (rt *RuntimeBehavior) applyFrameRate() {
+	old, ok := rt.frame.applyRequest()
+	if !ok {
+		return
+	}
+	fps := rt.frame.TargetFPS()
+	if fps == old {
+		return
+	}
+	rt.retuneFrameTicker(fps)
+	rt.emitEventRunningEvent(runtime.RunningEvent_TargetFPSChanged, old, fps)
+}
+
+// adaptFrameRate 在帧结束后按自适应帧率设置调节目标 FPS。
+func (rt *RuntimeBehavior) adaptFrameRate() {
+	if !rt.options.Frame.Adaptive {
+		return
+	}
+	old, ok := rt.frame.adapt(rt.options.Frame.MinFPS)
+	if !ok {
+		return
+	}
+	fps := rt.frame.TargetFPS()
+	rt.retuneFrameTicker(fps)
+	rt.emitEventRunningEvent(runtime.RunningEvent_TargetFPSAdapted, old, fps)
+}
+
+// retuneFrameTicker 通知帧任务调度器按新的目标 FPS 重置节拍，仅保留最新值。
+func (rt *RuntimeBehavior) retuneFrameTicker(fps float64) {
+	select {
+	case <-rt.frameRate:
+	default:
+	}
+	rt.frameRate <- fps
+}
@@ -0,0 +1,229 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/event"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// JournalEntry 是生命周期日志中的一条记录。
+type JournalEntry struct {
+	Seq       int64       // 记录序号，从 1 开始递增。
+	Time      time.Time   // 记录时间。
+	TaskID    int64       // 记录时 Runtime 正在执行的任务序号，从 1 开始；0 表示不在任务中，例如启动与停止阶段或时间定时器回调。
+	Kind      JournalKind // 记录类别。
+	EntityID  uid.ID      // 相关实体 ID。
+	Component string      // 相关组件名；与组件无关时为空。
+	From      string      // 变化前的状态或父实体 ID；由实体管理器推进的状态变化无法得知原状态，此时为空。
+	To        string      // 变化后的状态或父实体 ID。
+	Error     error       // 激活 panic 的错误信息。
+}
+
+type _JournalEntryJSON struct {
+	Seq       int64     `json:"seq"`
+	Time      time.Time `json:"time"`
+	TaskID    int64     `json:"task_id"`
+	Kind      string    `json:"kind"`
+	EntityID  uid.ID    `json:"entity_id"`
+	Component string    `json:"component,omitempty"`
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// MarshalJSON 将记录编码为 JSON。
+func (entry JournalEntry) MarshalJSON() ([]byte, error) {
+	entryJSON := _JournalEntryJSON{
+		Seq:       entry.Seq,
+		Time:      entry.Time,
+		TaskID:    entry.TaskID,
+		Kind:      entry.Kind.String(),
+		EntityID:  entry.EntityID,
+		Component: entry.Component,
+		From:      entry.From,
+		To:        entry.To,
+	}
+	if entry.Error != nil {
+		entryJSON.Error = entry.Error.Error()
+	}
+	return json.Marshal(entryJSON)
+}
+
+// LifecycleJournal 是 Runtime 的有界生命周期日志，写满后覆盖最早的记录。
+// 查询方法可在任意 goroutine 中调用；nil 日志表示未启用，查询返回空结果。
+type LifecycleJournal struct {
+	mutex   sync.Mutex
+	entries []JournalEntry
+	head    int
+	seq     int64
+}
+
+// Entries 返回全部记录的副本，按记录先后排列。
+func (j *LifecycleJournal) Entries() []JournalEntry {
+	return j.query(uid.Nil)
+}
+
+// EntityEntries 返回与实体 entityID 相关记录的副本，按记录先后排列。
+func (j *LifecycleJournal) EntityEntries(entityID uid.ID) []JournalEntry {
+	if entityID.IsNil() {
+		return nil
+	}
+	return j.query(entityID)
+}
+
+// WriteJSON 将与实体 entityID 相关的记录以 JSON 数组写入 w；entityID 为空时写入全部记录。
+func (j *LifecycleJournal) WriteJSON(w io.Writer, entityID uid.ID) error {
+	entries := j.query(entityID)
+	if entries == nil {
+		entries = []JournalEntry{}
+	}
+	return json.NewEncoder(w).Encode(entries)
+}
+
+func (j *LifecycleJournal) init(size int) {
+	j.entries = make([]JournalEntry, 0, size)
+}
+
+func (j *LifecycleJournal) record(entry JournalEntry) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.seq++
+	entry.Seq = j.seq
+	entry.Time = time.Now()
+
+	if len(j.entries) < cap(j.entries) {
+		j.entries = append(j.entries, entry)
+		return
+	}
+	j.entries[j.head] = entry
+	j.head = (j.head + 1) % len(j.entries)
+}
+
+func (j *LifecycleJournal) query(entityID uid.ID) []JournalEntry {
+	if j == nil {
+		return nil
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	var entries []JournalEntry
+	for _, part := range [2][]JournalEntry{j.entries[j.head:], j.entries[:j.head]} {
+		for i := range part {
+			if entityID.IsNil() || part[i].EntityID == entityID {
+				entries = append(entries, part[i])
+			}
+		}
+	}
+	return entries
+}
+
+type iRuntimeJournal interface {
+	// Journal 返回生命周期日志；未通过 JournalSize 启用时返回 nil。
+	Journal() *LifecycleJournal
+}
+
+// Journal 返回生命周期日志；未通过 JournalSize 启用时返回 nil。
+func (rt *RuntimeBehavior) Journal() *LifecycleJournal {
+	return rt.journal
+}
+
+func (rt *RuntimeBehavior) journalRecord(kind JournalKind, entityID uid.ID, component, from, to string, err error) {
+	if rt.journal == nil {
+		return
+	}
+	rt.journal.record(JournalEntry{
+		TaskID:    rt.curTaskID,
+		Kind:      kind,
+		EntityID:  entityID,
+		Component: component,
+		From:      from,
+		To:        to,
+		Error:     err,
+	})
+}
+
+// setEntityState 推进实体生命周期状态，并记录到生命周期日志。
+func (rt *RuntimeBehavior) setEntityState(entity ec.Entity, state ec.EntityState) {
+	rt.journalRecord(JournalKind_EntityState, entity.ID(), "", entity.State().String(), state.String(), nil)
+	ec.UnsafeEntity(entity).SetState(state)
+}
+
+// setComponentState 推进组件生命周期状态，并记录到生命周期日志。
+func (rt *RuntimeBehavior) setComponentState(comp ec.Component, state ec.ComponentState) {
+	rt.journalRecord(JournalKind_ComponentState, comp.Entity().ID(), comp.Name(), comp.State().String(), state.String(), nil)
+	ec.UnsafeComponent(comp).SetState(state)
+}
+
+// journalEntityManaged 记录由实体管理器推进、运行时仅能观察到的实体状态变化。
+func (rt *RuntimeBehavior) journalEntityManaged(entity ec.Entity, from string) {
+	rt.journalRecord(JournalKind_EntityState, entity.ID(), "", from, entity.State().String(), nil)
+}
+
+func (rt *RuntimeBehavior) journalComponents(kind JournalKind, entity ec.Entity, components ...ec.Component) {
+	for _, comp := range components {
+		rt.journalRecord(kind, entity.ID(), comp.Name(), "", "", nil)
+	}
+}
+
+// journalRunningEvent 记录激活中止的运行事件。
+func (rt *RuntimeBehavior) journalRunningEvent(runningEvent runtime.RunningEvent, args ...any) {
+	switch runningEvent {
+	case runtime.RunningEvent_EntityActivationAborted:
+		entity := args[0].(ec.Entity)
+		rt.journalRecord(JournalKind_ActivationAborted, entity.ID(), "", "", "", nil)
+	case runtime.RunningEvent_EntitiesActivationAborted:
+		for _, entity := range args[0].([]ec.Entity) {
+			rt.journalRecord(JournalKind_ActivationAborted, entity.ID(), "", "", "", nil)
+		}
+	case runtime.RunningEvent_EntityComponentsActivationAborted:
+		rt.journalComponents(JournalKind_ActivationAborted, args[0].(ec.Entity), args[1].([]ec.Component)...)
+	}
+}
+
+// observeEntityTree 在启用生命周期日志时记录实体树节点的变化。
+func (rt *RuntimeBehavior) observeEntityTree() []event.Handle {
+	if rt.journal == nil {
+		return nil
+	}
+
+	entityTree := rt.ctx.EntityTree()
+
+	return []event.Handle{
+		runtime.BindEventEntityTreeAddNode(entityTree, runtime.HandleEventEntityTreeAddNode(func(entityTree runtime.EntityTree, parentID, childID uid.ID) {
+			rt.journalRecord(JournalKind_TreeNodeAdd, childID, "", "", parentID.String(), nil)
+		})),
+		runtime.BindEventEntityTreeRemoveNode(entityTree, runtime.HandleEventEntityTreeRemoveNode(func(entityTree runtime.EntityTree, parentID, childID uid.ID) {
+			rt.journalRecord(JournalKind_TreeNodeRemove, childID, "", parentID.String(), "", nil)
+		})),
+		runtime.BindEventEntityTreeMoveNode(entityTree, runtime.HandleEventEntityTreeMoveNode(func(entityTree runtime.EntityTree, childID, fromParentID, toParentID uid.ID) {
+			rt.journalRecord(JournalKind_TreeNodeMove, childID, "", fromParentID.String(), toParentID.String(), nil)
+		})),
+	}
+}
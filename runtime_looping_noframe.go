@@ -31,9 +31,28 @@ func (rt *RuntimeBehavior) loopingNoFrame() {
 
 loop:
 	for {
+		if rt.paused.Load() {
+			if !rt.runPaused() {
+				break loop
+			}
+			continue
+		}
+
 		select {
 		case task := <-taskOut:
-			rt.runTask(task)
+			rt.runTasks(task)
+
+		case <-rt.taskQueue.ready():
+			if task, ok := rt.taskQueue.poll(); ok {
+				rt.runTasks(task)
+			}
+
+		case <-rt.timers.C():
+			rt.runTimers()
+
+		case <-rt.timers.Wake():
+
+		case <-rt.control:
 
 		case <-gcTicker.C:
 			rt.runGC()
@@ -45,9 +64,9 @@ loop:
 
 	rt.taskQueue.close()
 
-	for task := range taskOut {
-		rt.runTask(task)
-	}
+	rt.taskQueue.drain(func(task _Task) {
+		rt.runTask(task, true)
+	})
 
 	rt.runGC()
 }
@@ -33,15 +33,34 @@ func (rt *RuntimeBehavior) loopingRealTime() {
 	var wg sync.WaitGroup
 
 	wg.Add(1)
-	go rt.scheduleFrameTasks(&wg, rt.frame.CurFrames()+1, rt.frame.TotalFrames(), rt.frame.TargetFPS())
+	go rt.scheduleFrameTasks(&wg, rt.frame.TargetFPS())
 
 	taskOut := rt.taskQueue.out()
 
 loop:
 	for rt.frameLoopBegin(); ; {
+		if rt.paused.Load() {
+			if !rt.runPaused() {
+				break loop
+			}
+			continue
+		}
+
 		select {
 		case task := <-taskOut:
-			rt.runTask(task)
+			rt.runTasks(task)
+
+		case <-rt.taskQueue.ready():
+			if task, ok := rt.taskQueue.poll(); ok {
+				rt.runTasks(task)
+			}
+
+		case <-rt.timers.C():
+			rt.runTimers()
+
+		case <-rt.timers.Wake():
+
+		case <-rt.control:
 
 		case <-gcTicker.C:
 			rt.runGC()
@@ -54,56 +73,84 @@ loop:
 	wg.Wait()
 	rt.taskQueue.close()
 
-	for task := range taskOut {
-		rt.runTask(task)
-	}
+	rt.taskQueue.drain(func(task _Task) {
+		rt.runTask(task, true)
+	})
 
 	rt.runGC()
 	rt.frameLoopEnd()
 }
 
-func (rt *RuntimeBehavior) scheduleFrameTasks(wg *sync.WaitGroup, curFrames, totalFrames int64, targetFPS float64) {
+// scheduleFrameTasks 按目标 FPS 投递帧任务，暂停期间跳过；目标 FPS 改变时重置节拍。
+func (rt *RuntimeBehavior) scheduleFrameTasks(wg *sync.WaitGroup, targetFPS float64) {
 	defer wg.Done()
 
-	updateTicker := time.NewTicker(time.Duration(float64(time.Second) / targetFPS))
+	updateTicker := time.NewTicker(frameInterval(targetFPS))
 	defer updateTicker.Stop()
 
 	done := make(chan struct{}, 1)
 
 	for {
-		if totalFrames > 0 && curFrames >= totalFrames {
-			rt.Terminate()
-			return
-		}
-
 		select {
 		case <-updateTicker.C:
+			if rt.paused.Load() {
+				continue
+			}
 			if !rt.taskQueue.enqueueFrame(rt.ctx, rt.frameLoop, done) {
 				return
 			}
-			curFrames++
+		case fps := <-rt.frameRate:
+			updateTicker.Reset(frameInterval(fps))
 		case <-rt.ctx.Done():
 			return
 		}
 	}
 }
 
+func frameInterval(fps float64) time.Duration {
+	return time.Duration(float64(time.Second) / fps)
+}
+
 func (rt *RuntimeBehavior) frameLoop(runtime.Context, ...any) {
+	if rt.lastFrameBegun() {
+		return
+	}
 	rt.frameLoopEnd()
 	rt.frameLoopBegin()
 }
 
+// lastFrameBegun 返回是否已经开始 TotalFrames 限制内的最后一帧。
+func (rt *RuntimeBehavior) lastFrameBegun() bool {
+	totalFrames := rt.frame.TotalFrames()
+	return totalFrames > 0 && rt.frame.CurFrames()+1 >= totalFrames
+}
+
 func (rt *RuntimeBehavior) frameLoopBegin() {
+	defer rt.taskQueue.setRunning(rt.taskQueue.setRunning(true))
+
+	rt.applyFrameRate()
+
 	rt.emitEventRunningEvent(runtime.RunningEvent_FrameLoopBegin)
+	rt.timers.runFrames()
+
 	rt.emitEventRunningEvent(runtime.RunningEvent_FrameUpdateBegin)
 
 	_EmitEventUpdate(&rt.runtimeEventTab)
 	_EmitEventLateUpdate(&rt.runtimeEventTab)
 
 	rt.emitEventRunningEvent(runtime.RunningEvent_FrameUpdateEnd)
+	rt.checkFrameBudget()
+
+	if rt.lastFrameBegun() {
+		rt.Terminate()
+	}
 }
 
 func (rt *RuntimeBehavior) frameLoopEnd() {
+	defer rt.taskQueue.setRunning(rt.taskQueue.setRunning(true))
+
 	rt.emitEventRunningEvent(runtime.RunningEvent_FrameLoopEnd)
 	rt.frame.setCurFrames(rt.frame.CurFrames() + 1)
+
+	rt.adaptFrameRate()
 }
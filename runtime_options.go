@@ -20,8 +20,10 @@
 package core
 
 import (
+	"maps"
 	"time"
 
+	"git.golaxy.org/core/ec"
 	"git.golaxy.org/core/utils/exception"
 	"git.golaxy.org/core/utils/generic"
 	"git.golaxy.org/core/utils/iface"
@@ -34,13 +36,18 @@ type (
 
 // RuntimeOptions 定义创建运行时及其工作循环时使用的选项。
 type RuntimeOptions struct {
-	InstanceFace                    iface.Face[Runtime] // 自定义运行时实例及其接口缓存。
-	AutoRun                         bool                // 是否在 RunningEvent_Birth 后自动启动运行时。
-	ContinueOnActivatingEntityPanic bool                // 激活实体发生 panic 后是否继续；为 false 时销毁该实体。
-	Frame                           FrameOptions        // 帧循环配置。
-	TaskQueue                       TaskQueueOptions    // 任务队列配置。
-	GCInterval                      time.Duration       // 两次运行时 GC 之间的最短间隔。
-	CustomGC                        CustomGC            // 内置清理完成后执行的自定义 GC。
+	InstanceFace                    iface.Face[Runtime]          // 自定义运行时实例及其接口缓存。
+	AutoRun                         bool                         // 是否在 RunningEvent_Birth 后自动启动运行时。
+	ContinueOnActivatingEntityPanic bool                         // 激活实体发生 panic 后是否继续；为 false 时销毁该实体。
+	UpgradeEntityOnRedeclare        bool                         // 实体原型重新声明后，是否将本运行时中的存量实体升级到新版本。
+	UpdateOrders                    map[string]int32             // 按组件原型名覆盖 Update 与 LateUpdate 的执行顺序值。
+	UpdateIntervals                 map[string]ec.UpdateInterval // 按组件原型名覆盖 Update 与 LateUpdate 的节流间隔。
+	JournalSize                     int                          // 生命周期日志保留的记录数；0 表示不启用。
+	Frame                           FrameOptions                 // 帧循环配置。
+	TaskQueue                       TaskQueueOptions             // 任务队列配置。
+	GCInterval                      time.Duration                // 两次运行时 GC 之间的最短间隔。
+	TimerTick                       time.Duration                // 运行时时间轮的刻度，即时间定时器的触发精度。
+	CustomGC                        CustomGC                     // 内置清理完成后执行的自定义 GC。
 }
 
 type _RuntimeOption struct{}
@@ -51,9 +58,14 @@ func (_RuntimeOption) Default() option.Setting[RuntimeOptions] {
 		With.Runtime.InstanceFace(iface.Face[Runtime]{}).Apply(options)
 		With.Runtime.AutoRun(false).Apply(options)
 		With.Runtime.ContinueOnActivatingEntityPanic(false).Apply(options)
+		With.Runtime.UpgradeEntityOnRedeclare(false).Apply(options)
+		With.Runtime.UpdateOrders(nil).Apply(options)
+		With.Runtime.UpdateIntervals(nil).Apply(options)
+		With.Runtime.JournalSize(0).Apply(options)
 		With.Runtime.Frame(With.Frame.Default()).Apply(options)
 		With.Runtime.TaskQueue(With.TaskQueue.Default()).Apply(options)
 		With.Runtime.GCInterval(10 * time.Second).Apply(options)
+		With.Runtime.TimerTick(time.Millisecond).Apply(options)
 		With.Runtime.CustomGC(nil).Apply(options)
 	}
 }
@@ -80,6 +92,41 @@ func (_RuntimeOption) ContinueOnActivatingEntityPanic(b bool) option.Setting[Run
 	}
 }
 
+// UpgradeEntityOnRedeclare 设置实体原型重新声明后，是否将本运行时中的存量实体升级到新版本。
+// 升级会为实体补齐新增的内建组件，并删除不再声明且允许删除的内建组件。
+func (_RuntimeOption) UpgradeEntityOnRedeclare(b bool) option.Setting[RuntimeOptions] {
+	return func(options *RuntimeOptions) {
+		options.UpgradeEntityOnRedeclare = b
+	}
+}
+
+// UpdateOrders 设置按组件原型名覆盖的 Update 与 LateUpdate 执行顺序值，优先于组件原型通过 ec.ComponentUpdateOrder 声明的值；
+// orders 会被复制。
+func (_RuntimeOption) UpdateOrders(orders map[string]int32) option.Setting[RuntimeOptions] {
+	return func(options *RuntimeOptions) {
+		options.UpdateOrders = maps.Clone(orders)
+	}
+}
+
+// UpdateIntervals 设置按组件原型名覆盖的 Update 与 LateUpdate 节流间隔，优先于组件原型通过 ec.ComponentUpdateInterval 声明的值；
+// 零值表示每帧更新，intervals 会被复制。
+func (_RuntimeOption) UpdateIntervals(intervals map[string]ec.UpdateInterval) option.Setting[RuntimeOptions] {
+	return func(options *RuntimeOptions) {
+		options.UpdateIntervals = maps.Clone(intervals)
+	}
+}
+
+// JournalSize 设置生命周期日志保留的记录数，写满后覆盖最早的记录；0 表示不启用，负值会导致 panic。
+// 启用后 Runtime 记录实体与组件的状态变化、组件增删、实体树变化以及激活中止，可通过 Runtime.Journal 查询。
+func (_RuntimeOption) JournalSize(n int) option.Setting[RuntimeOptions] {
+	return func(options *RuntimeOptions) {
+		if n < 0 {
+			exception.Panicf("%w: %w: JournalSize must be greater than or equal to 0", ErrRuntime, ErrArgs)
+		}
+		options.JournalSize = n
+	}
+}
+
 // Frame 追加帧循环设置。
 func (_RuntimeOption) Frame(settings ...option.Setting[FrameOptions]) option.Setting[RuntimeOptions] {
 	return func(options *RuntimeOptions) {
@@ -104,6 +151,17 @@ func (_RuntimeOption) GCInterval(dur time.Duration) option.Setting[RuntimeOption
 	}
 }
 
+// TimerTick 设置运行时时间轮的刻度，dur 必须大于 0。
+// 刻度越小触发越精确，但有待触发定时器时 Runtime 唤醒得越频繁。
+func (_RuntimeOption) TimerTick(dur time.Duration) option.Setting[RuntimeOptions] {
+	return func(options *RuntimeOptions) {
+		if dur <= 0 {
+			exception.Panicf("%w: %w: TimerTick must be greater than 0", ErrRuntime, ErrArgs)
+		}
+		options.TimerTick = dur
+	}
+}
+
 // CustomGC 设置内置清理完成后执行的自定义 GC 函数。
 func (_RuntimeOption) CustomGC(fn CustomGC) option.Setting[RuntimeOptions] {
 	return func(options *RuntimeOptions) {
@@ -86,6 +86,8 @@ func (rt *RuntimeBehavior) running() {
 
 	rt.loopStop(handles)
 
+	rt.timers.stopAll()
+
 	ctx.AsyncScope().Close()
 	<-ctx.AsyncScope().Completion().Done()
 
@@ -119,6 +121,8 @@ func (rt *RuntimeBehavior) onBeforeContextRunningEvent(ctx runtime.Context, runn
 		rt.frame.updateEnd()
 	case runtime.RunningEvent_FrameLoopEnd:
 		rt.frame.loopEnd()
+	case runtime.RunningEvent_EntityActivationAborted, runtime.RunningEvent_EntitiesActivationAborted, runtime.RunningEvent_EntityComponentsActivationAborted:
+		rt.journalRunningEvent(runningEvent, args...)
 	}
 }
 
@@ -169,9 +173,9 @@ func (rt *RuntimeBehavior) activateAddIn(status runtime.AddInStatus) {
 	}
 
 	if cb, ok := status.InstanceFace().Iface.(LifecycleAddInInit); ok {
-		generic.CastAction2(cb.Init).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError(), service.Current(rt), rt.ctx)
+		generic.CastAction2(cb.Init).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter(), service.Current(rt), rt.ctx)
 	} else if cb, ok := status.InstanceFace().Iface.(LifecycleRuntimeAddInInit); ok {
-		generic.CastAction1(cb.Init).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError(), rt.ctx)
+		generic.CastAction1(cb.Init).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter(), rt.ctx)
 	}
 
 	if status.State() != extension.AddInState_Loaded {
@@ -207,9 +211,9 @@ func (rt *RuntimeBehavior) deactivateAddIn(status runtime.AddInStatus) {
 	rt.emitEventRunningEvent(runtime.RunningEvent_AddInDeactivating, status)
 
 	if cb, ok := status.InstanceFace().Iface.(LifecycleAddInShut); ok {
-		generic.CastAction2(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError(), service.Current(rt), rt.ctx)
+		generic.CastAction2(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter(), service.Current(rt), rt.ctx)
 	} else if cb, ok := status.InstanceFace().Iface.(LifecycleRuntimeAddInShut); ok {
-		generic.CastAction1(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.ReportError(), rt.ctx)
+		generic.CastAction1(cb.Shut).Call(rt.ctx.AutoRecover(), rt.ctx.PanicReporter(), rt.ctx)
 	}
 
 	rt.emitEventRunningEvent(runtime.RunningEvent_AddInDeactivated, status)
@@ -222,14 +226,19 @@ func (rt *RuntimeBehavior) loopStart() []event.Handle {
 		rt.frame.runningBegin()
 	}
 
-	return []event.Handle{
+	if rt.options.UpgradeEntityOnRedeclare {
+		rt.watchEntityPT()
+	}
+
+	return append([]event.Handle{
 		runtime.BindEventEntityManagerAddEntity(ctx.EntityManager(), rt.handleEventEntityManagerAddEntity),
+		runtime.BindEventEntityManagerAddEntities(ctx.EntityManager(), rt.handleEventEntityManagerAddEntities),
 		runtime.BindEventEntityManagerRemoveEntity(ctx.EntityManager(), rt.handleEventEntityManagerRemoveEntity),
 		runtime.BindEventEntityManagerEntityAddComponents(ctx.EntityManager(), rt.handleEventEntityManagerEntityAddComponents),
 		runtime.BindEventEntityManagerEntityRemoveComponent(ctx.EntityManager(), rt.handleEventEntityManagerEntityRemoveComponent),
 		runtime.BindEventEntityManagerEntityComponentEnableChanged(ctx.EntityManager(), rt.handleEventEntityManagerEntityComponentEnableChanged),
 		runtime.BindEventEntityManagerEntityFirstTouchComponent(ctx.EntityManager(), rt.handleEventEntityManagerEntityFirstTouchComponent),
-	}
+	}, rt.observeEntityTree()...)
 }
 
 func (rt *RuntimeBehavior) loopStop(handles []event.Handle) {
@@ -248,12 +257,61 @@ func (rt *RuntimeBehavior) mainLoop() {
 	}
 }
 
-func (rt *RuntimeBehavior) runTask(task _Task) {
+// runTasks 执行 first，并按 TaskQueue 的批次设置继续非阻塞地排空队列。
+func (rt *RuntimeBehavior) runTasks(first _Task) {
+	options := &rt.options.TaskQueue
+	batchEvents := options.BatchEvents && options.BatchSize > 1
+
+	var deadline time.Time
+	if options.BatchTime > 0 {
+		deadline = time.Now().Add(options.BatchTime)
+	}
+
+	inCall := false
+	size := 0
+
+	for task, ok := first, true; ok; task, ok = rt.taskQueue.poll() {
+		if batchEvents {
+			isCall := task.typ != TaskType_Frame
+			if isCall != inCall {
+				if isCall {
+					rt.emitEventRunningEvent(runtime.RunningEvent_RunCallBegin)
+				} else {
+					rt.emitEventRunningEvent(runtime.RunningEvent_RunCallEnd)
+				}
+				inCall = isCall
+			}
+		}
+
+		size++
+		rt.runTask(task, !batchEvents)
+
+		// 批次中的任务请求暂停后立即停止出队，其余任务留待 Step 或 Resume
+		if rt.paused.Load() || size >= options.BatchSize || (!deadline.IsZero() && !time.Now().Before(deadline)) {
+			break
+		}
+	}
+
+	if inCall {
+		rt.emitEventRunningEvent(runtime.RunningEvent_RunCallEnd)
+	}
+	rt.taskQueue.batchStats.record(size)
+}
+
+func (rt *RuntimeBehavior) runTask(task _Task, callEvents bool) {
 	rt.taskQueue.start(task.typ)
 	rt.lastProgressTime.Store(time.Now().UnixNano())
 
+	rt.taskSeq++
+	prevTaskID := rt.curTaskID
+	rt.curTaskID = rt.taskSeq
+
+	prevRunning := rt.taskQueue.setRunning(true)
+
 	var panicked bool
 	defer func() {
+		rt.curTaskID = prevTaskID
+		rt.taskQueue.setRunning(prevRunning)
 		if panicValue := recover(); panicValue != nil {
 			panicked = true
 			rt.finishTask(task.typ, panicked)
@@ -263,9 +321,13 @@ func (rt *RuntimeBehavior) runTask(task _Task) {
 	}()
 	switch task.typ {
 	case TaskType_Submit, TaskType_Post:
-		rt.emitEventRunningEvent(runtime.RunningEvent_RunCallBegin)
+		if callEvents {
+			rt.emitEventRunningEvent(runtime.RunningEvent_RunCallBegin)
+		}
 		panicked = task.run(rt.ctx)
-		rt.emitEventRunningEvent(runtime.RunningEvent_RunCallEnd)
+		if callEvents {
+			rt.emitEventRunningEvent(runtime.RunningEvent_RunCallEnd)
+		}
 	case TaskType_Frame:
 		panicked = task.run(rt.ctx)
 	}
@@ -276,7 +338,17 @@ func (rt *RuntimeBehavior) finishTask(taskType TaskType, panicked bool) {
 	rt.lastProgressTime.Store(time.Now().UnixNano())
 }
 
+func (rt *RuntimeBehavior) runTimers() {
+	defer rt.taskQueue.setRunning(rt.taskQueue.setRunning(true))
+
+	rt.lastProgressTime.Store(time.Now().UnixNano())
+	rt.timers.runTime()
+	rt.lastProgressTime.Store(time.Now().UnixNano())
+}
+
 func (rt *RuntimeBehavior) runGC() {
+	defer rt.taskQueue.setRunning(rt.taskQueue.setRunning(true))
+
 	rt.emitEventRunningEvent(runtime.RunningEvent_RunGCBegin)
 	rt.gc()
 	rt.emitEventRunningEvent(runtime.RunningEvent_RunGCEnd)
@@ -19,7 +19,10 @@
 
 package core
 
-import "git.golaxy.org/core/utils/async"
+import (
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/async"
+)
 
 // TaskQueueStats 描述一种调度语义的 Runtime 邮箱统计。
 type TaskQueueStats struct {
@@ -31,6 +34,7 @@ type TaskQueueStats struct {
 	Panicked       int64 // Completed 中恢复过 panic 的数量。
 	RejectedClosed int64 // 因队列关闭而拒绝的数量。
 	RejectedFull   int64 // 因有界队列容量不足而拒绝的数量。
+	Dropped        int64 // 被溢出策略丢弃的数量，含入队后被挤出的任务。
 }
 
 // RuntimeTaskStats 按 Submit、Post 和 Frame 三种调度语义分类。
@@ -40,6 +44,14 @@ type RuntimeTaskStats struct {
 	Frame  TaskQueueStats
 }
 
+// RuntimeBatchStats 描述 Runtime 每次唤醒连续执行任务的批次分布。
+type RuntimeBatchStats struct {
+	Batches int64                   // 批次总数。
+	Tasks   int64                   // 批次中执行的任务总数。
+	MaxSize int64                   // 最大批次大小。
+	Sizes   [taskBatchBuckets]int64 // 批次大小分布，第 i 个桶统计大小在 [2^i, 2^(i+1)) 的批次，最后一个桶包含更大的批次。
+}
+
 // RuntimeHealthStats 描述 Runtime 当前执行健康状态。
 type RuntimeHealthStats struct {
 	LastProgressTime int64          // 最近一次开始或完成任务的 UnixNano。
@@ -53,8 +65,10 @@ type RuntimeStats struct {
 	WaitGroupCount  int64
 	WaitGroupClosed bool
 	Tasks           RuntimeTaskStats
+	Batches         RuntimeBatchStats
 	Scope           async.ScopeStats
 	Health          RuntimeHealthStats
+	Messages        []runtime.MessageStats // 按消息类型统计的 Ask/Tell，按类型名排序。
 }
 
 type iRuntimeStats interface {
@@ -71,6 +85,19 @@ func snapshotTaskStats(stats *_TaskQueueStats) TaskQueueStats {
 		Panicked:       stats.panicked.Load(),
 		RejectedClosed: stats.rejectedClosed.Load(),
 		RejectedFull:   stats.rejectedFull.Load(),
+		Dropped:        stats.dropped.Load(),
+	}
+	return ret
+}
+
+func snapshotBatchStats(stats *_TaskBatchStats) RuntimeBatchStats {
+	ret := RuntimeBatchStats{
+		Batches: stats.batches.Load(),
+		Tasks:   stats.tasks.Load(),
+		MaxSize: stats.maxSize.Load(),
+	}
+	for i := range stats.sizes {
+		ret.Sizes[i] = stats.sizes[i].Load()
 	}
 	return ret
 }
@@ -85,11 +112,13 @@ func (rt *RuntimeBehavior) Stats() RuntimeStats {
 			Post:   snapshotTaskStats(&rt.taskQueue.stats[TaskType_Post]),
 			Frame:  snapshotTaskStats(&rt.taskQueue.stats[TaskType_Frame]),
 		},
-		Scope: rt.ctx.AsyncScope().Stats(),
+		Batches: snapshotBatchStats(&rt.taskQueue.batchStats),
+		Scope:   rt.ctx.AsyncScope().Stats(),
 		Health: RuntimeHealthStats{
 			LastProgressTime: rt.lastProgressTime.Load(),
 			BlockedFutureID:  rt.ctx.BlockedFutureID(),
 			LastWaitRejectID: rt.ctx.LastWaitRejectID(),
 		},
+		Messages: rt.ctx.MessageStats().Stats(),
 	}
 }
@@ -52,13 +52,13 @@ func (task _Task) run(ctx runtime.Context) (panicked bool) {
 
 	switch {
 	case task.fun != nil:
-		ret, panicErr = task.fun.Call(ctx.AutoRecover(), ctx.ReportError(), ctx, task.args...)
+		ret, panicErr = task.fun.Call(ctx.AutoRecover(), ctx.PanicReporter(), ctx, task.args...)
 	case task.action != nil:
-		panicErr = task.action.Call(ctx.AutoRecover(), ctx.ReportError(), ctx, task.args...)
+		panicErr = task.action.Call(ctx.AutoRecover(), ctx.PanicReporter(), ctx, task.args...)
 	case task.delegate != nil:
-		ret, panicErr = task.delegate.Call(ctx.AutoRecover(), ctx.ReportError(), nil, ctx, task.args...)
+		ret, panicErr = task.delegate.Call(ctx.AutoRecover(), ctx.PanicReporter(), nil, ctx, task.args...)
 	case task.delegateVoid != nil:
-		panicErr = task.delegateVoid.Call(ctx.AutoRecover(), ctx.ReportError(), nil, ctx, task.args...)
+		panicErr = task.delegateVoid.Call(ctx.AutoRecover(), ctx.PanicReporter(), nil, ctx, task.args...)
 	}
 
 	if panicErr != nil {
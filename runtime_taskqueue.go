@@ -21,17 +21,22 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/bits"
 	"sync/atomic"
+	"time"
 
 	"git.golaxy.org/core/runtime"
 	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/exception"
 	"git.golaxy.org/core/utils/generic"
 )
 
 var (
-	ErrTaskQueueClosed = fmt.Errorf("%w: task queue is closed", ErrRuntime) // 任务队列已关闭。
-	ErrTaskQueueFull   = fmt.Errorf("%w: task queue is full", ErrRuntime)   // 任务队列已满。
+	ErrTaskQueueClosed = fmt.Errorf("%w: task queue is closed", ErrRuntime)            // 任务队列已关闭。
+	ErrTaskQueueFull   = fmt.Errorf("%w: task queue is full", ErrRuntime)              // 任务队列已满。
+	ErrTaskDropped     = fmt.Errorf("%w: task dropped by overflow policy", ErrRuntime) // 任务被溢出策略丢弃。
 )
 
 type _TaskQueueStats struct {
@@ -43,24 +48,77 @@ type _TaskQueueStats struct {
 	panicked       atomic.Int64
 	rejectedClosed atomic.Int64
 	rejectedFull   atomic.Int64
+	dropped        atomic.Int64
+}
+
+// taskBatchBuckets 是批次大小分布的桶数，第 i 个桶统计大小在 [2^i, 2^(i+1)) 的批次，最后一个桶包含更大的批次。
+const taskBatchBuckets = 8
+
+type _TaskBatchStats struct {
+	batches atomic.Int64
+	tasks   atomic.Int64
+	maxSize atomic.Int64
+	sizes   [taskBatchBuckets]atomic.Int64
+}
+
+func (stats *_TaskBatchStats) record(size int) {
+	stats.batches.Add(1)
+	stats.tasks.Add(int64(size))
+	if int64(size) > stats.maxSize.Load() {
+		stats.maxSize.Store(int64(size))
+	}
+	stats.sizes[min(bits.Len(uint(size))-1, taskBatchBuckets-1)].Add(1)
 }
 
 type _TaskQueue struct {
 	barrier       generic.Barrier
 	boundedChan   chan _Task
 	unboundedChan *generic.UnboundedChannel[_Task]
+	ring          *_TaskRing
+	deque         *_TaskDeque
+	overflow      TaskQueueOverflow
+	blockTimeout  time.Duration
 	stats         [taskTypeCount]_TaskQueueStats
+	batchStats    _TaskBatchStats
+	depth         atomic.Int64
+	watermark     atomic.Int64 // 最近一次越过水位时的积压数左移一位，最低位为 1 表示处于高水位。
+	notifying     atomic.Bool  // 是否有 goroutine 正在投递水位回调。
+	notifiedHigh  bool         // 最近投递的是否为高水位回调，只由投递中的 goroutine 访问。
+	lowWatermark  int64
+	highWatermark int64
+	watermarkCB   func(high bool, depth int64)
 }
 
-func (q *_TaskQueue) init(unbounded bool, capacity int) {
-	if unbounded {
+func (q *_TaskQueue) init(options TaskQueueOptions, watermarkCB func(high bool, depth int64)) {
+	q.lowWatermark = int64(options.LowWatermark)
+	q.highWatermark = int64(options.HighWatermark)
+	q.watermarkCB = watermarkCB
+
+	if !options.Unbounded && options.Overflow != TaskQueueOverflow_Reject && options.Backend == TaskQueueBackend_Ring {
+		exception.Panicf("%w: %w: bounded Ring TaskQueue only supports TaskQueueOverflow_Reject", ErrRuntime, ErrArgs)
+	}
+
+	switch {
+	case !options.Unbounded && options.Overflow != TaskQueueOverflow_Reject:
+		q.deque = &_TaskDeque{}
+		q.deque.init(options.Capacity)
+		q.overflow = options.Overflow
+		q.blockTimeout = options.BlockTimeout
+	case options.Backend == TaskQueueBackend_Ring:
+		if !options.Unbounded && (options.Capacity < 2 || options.Capacity&(options.Capacity-1) != 0) {
+			exception.Panicf("%w: %w: Capacity of a bounded Ring TaskQueue must be a power of 2 and at least 2", ErrRuntime, ErrArgs)
+		}
+		q.ring = &_TaskRing{}
+		q.ring.init(options.Unbounded, options.Capacity)
+	case options.Unbounded:
 		q.unboundedChan = generic.NewUnboundedChannel[_Task]()
-	} else {
-		q.boundedChan = make(chan _Task, capacity)
+	default:
+		q.boundedChan = make(chan _Task, options.Capacity)
 	}
 }
 
 func (q *_TaskQueue) enqueueSubmit(
+	ctx context.Context,
 	executorID async.ExecutorID,
 	fun generic.FuncVar1[runtime.Context, any, async.Result],
 	action generic.ActionVar1[runtime.Context, any],
@@ -78,18 +136,19 @@ func (q *_TaskQueue) enqueueSubmit(
 		args:         args,
 		promise:      promise,
 	}
-	if err := q.tryEnqueue(task); err != nil {
+	if err := q.tryEnqueue(ctx, task); err != nil {
 		promise.Resolve(async.NewResult(nil, err))
 	}
 	return future
 }
 
 func (q *_TaskQueue) enqueuePost(
+	ctx context.Context,
 	action generic.ActionVar1[runtime.Context, any],
 	delegateVoid generic.DelegateVoidVar1[runtime.Context, any],
 	args []any,
 ) error {
-	return q.tryEnqueue(_Task{
+	return q.tryEnqueue(ctx, _Task{
 		typ:          TaskType_Post,
 		action:       action,
 		delegateVoid: delegateVoid,
@@ -101,43 +160,49 @@ func (q *_TaskQueue) enqueueFrame(ctx context.Context, action generic.ActionVar1
 	task := _Task{typ: TaskType_Frame, action: action, done: done}
 	stats := &q.stats[TaskType_Frame]
 
-	if q.boundedChan != nil {
+	switch {
+	case q.deque != nil:
 		stats.queued.Add(1)
-		select {
-		case q.boundedChan <- task:
-			stats.accepted.Add(1)
-			select {
-			case <-done:
-				return true
-			case <-ctx.Done():
-				stats.canceled.Add(1)
-				return false
-			}
-		case <-ctx.Done():
+		if _, _, err := q.deque.push(nil, task, q.overflow, 0); err != nil {
 			stats.queued.Add(-1)
 			stats.rejectedClosed.Add(1)
 			return false
 		}
-	}
-
-	if q.unboundedChan != nil {
+		q.accept(stats)
+	case q.ring != nil:
+		stats.queued.Add(1)
+		q.ring.push(task, true)
+		q.accept(stats)
+	case q.boundedChan != nil:
 		stats.queued.Add(1)
-		q.unboundedChan.In() <- task
-		stats.accepted.Add(1)
 		select {
-		case <-done:
-			return true
+		case q.boundedChan <- task:
+			q.accept(stats)
 		case <-ctx.Done():
-			stats.canceled.Add(1)
+			stats.queued.Add(-1)
+			stats.rejectedClosed.Add(1)
 			return false
 		}
+	case q.unboundedChan != nil:
+		stats.queued.Add(1)
+		q.unboundedChan.In() <- task
+		q.accept(stats)
+	default:
+		stats.rejectedClosed.Add(1)
+		return false
 	}
 
-	stats.rejectedClosed.Add(1)
-	return false
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		stats.canceled.Add(1)
+		return false
+	}
 }
 
-func (q *_TaskQueue) tryEnqueue(task _Task) error {
+// tryEnqueue 写入任务；ctx 仅限制 TaskQueueOverflow_Block 策略的等待，可以为 nil。
+func (q *_TaskQueue) tryEnqueue(ctx context.Context, task _Task) error {
 	stats := &q.stats[task.typ]
 	if !q.barrier.Join(1) {
 		stats.rejectedClosed.Add(1)
@@ -145,11 +210,46 @@ func (q *_TaskQueue) tryEnqueue(task _Task) error {
 	}
 	defer q.barrier.Done()
 
+	if q.deque != nil {
+		stats.queued.Add(1)
+		evicted, dropped, err := q.deque.push(ctx, task, q.overflow, q.blockTimeout)
+		for i := range evicted {
+			q.evict(&evicted[i])
+		}
+		switch {
+		case dropped:
+			stats.queued.Add(-1)
+			stats.dropped.Add(1)
+			return nil
+		case errors.Is(err, ErrTaskQueueClosed):
+			stats.queued.Add(-1)
+			stats.rejectedClosed.Add(1)
+			return err
+		case err != nil:
+			stats.queued.Add(-1)
+			stats.rejectedFull.Add(1)
+			return err
+		}
+		q.accept(stats)
+		return nil
+	}
+
+	if q.ring != nil {
+		stats.queued.Add(1)
+		if !q.ring.push(task, false) {
+			stats.queued.Add(-1)
+			stats.rejectedFull.Add(1)
+			return ErrTaskQueueFull
+		}
+		q.accept(stats)
+		return nil
+	}
+
 	if q.boundedChan != nil {
 		stats.queued.Add(1)
 		select {
 		case q.boundedChan <- task:
-			stats.accepted.Add(1)
+			q.accept(stats)
 			return nil
 		default:
 			stats.queued.Add(-1)
@@ -161,7 +261,7 @@ func (q *_TaskQueue) tryEnqueue(task _Task) error {
 	if q.unboundedChan != nil {
 		stats.queued.Add(1)
 		q.unboundedChan.In() <- task
-		stats.accepted.Add(1)
+		q.accept(stats)
 		return nil
 	}
 
@@ -169,6 +269,117 @@ func (q *_TaskQueue) tryEnqueue(task _Task) error {
 	return ErrTaskQueueClosed
 }
 
+func (q *_TaskQueue) accept(stats *_TaskQueueStats) {
+	stats.accepted.Add(1)
+	depth := q.depth.Add(1)
+	if q.highWatermark > 0 && depth >= q.highWatermark && q.crossWatermark(true, depth) {
+		q.notifyWatermark()
+	}
+}
+
+func (q *_TaskQueue) release() {
+	depth := q.depth.Add(-1)
+	if q.highWatermark > 0 && depth <= q.lowWatermark && q.crossWatermark(false, depth) {
+		q.notifyWatermark()
+	}
+}
+
+// crossWatermark 切换到 high 指定的水位状态并记录当时的积压数；已处于该状态时返回 false。
+func (q *_TaskQueue) crossWatermark(high bool, depth int64) bool {
+	var bit int64
+	if high {
+		bit = 1
+	}
+	for {
+		mark := q.watermark.Load()
+		if mark&1 == bit {
+			return false
+		}
+		if q.watermark.CompareAndSwap(mark, depth<<1|bit) {
+			return true
+		}
+	}
+}
+
+// notifyWatermark 按水位状态切换的顺序串行投递回调。已有 goroutine 在投递时直接返回，由其在结束前补发期间的切换；
+// 投递前已经相互抵消的高低切换不再回调。
+func (q *_TaskQueue) notifyWatermark() {
+	for q.notifying.CompareAndSwap(false, true) {
+		mark := q.watermark.Load()
+		for (mark&1 == 1) != q.notifiedHigh {
+			q.notifiedHigh = mark&1 == 1
+			q.watermarkCB(q.notifiedHigh, mark>>1)
+			mark = q.watermark.Load()
+		}
+		notified := q.notifiedHigh
+		q.notifying.Store(false)
+
+		if (q.watermark.Load()&1 == 1) == notified {
+			return
+		}
+	}
+}
+
+// evict 处理被溢出策略挤出队列的任务，Submit 任务的 Future 以 ErrTaskDropped 完成。
+func (q *_TaskQueue) evict(task *_Task) {
+	stats := &q.stats[task.typ]
+	stats.queued.Add(-1)
+	stats.dropped.Add(1)
+	q.release()
+	if task.typ == TaskType_Submit {
+		task.promise.Resolve(async.NewResult(nil, ErrTaskDropped))
+	}
+}
+
+// ready 返回 Ring 或双端队列实现中有任务可取时可读的频道；频道实现返回 nil。
+func (q *_TaskQueue) ready() <-chan struct{} {
+	switch {
+	case q.ring != nil:
+		return q.ring.ready()
+	case q.deque != nil:
+		return q.deque.ready()
+	}
+	return nil
+}
+
+// poll 非阻塞地取出一个任务。
+func (q *_TaskQueue) poll() (_Task, bool) {
+	switch {
+	case q.ring != nil:
+		return q.ring.pop()
+	case q.deque != nil:
+		return q.deque.pop()
+	}
+	select {
+	case task, ok := <-q.out():
+		return task, ok
+	default:
+		return _Task{}, false
+	}
+}
+
+// drain 在 close 后依次取出全部剩余任务。
+func (q *_TaskQueue) drain(fn func(_Task)) {
+	if q.ring != nil || q.deque != nil {
+		for task, ok := q.poll(); ok; task, ok = q.poll() {
+			fn(task)
+		}
+		return
+	}
+	for task := range q.out() {
+		fn(task)
+	}
+}
+
+// setRunning 设置 Runtime goroutine 是否正在执行任务，返回先前的值，只能由 Runtime goroutine 调用。
+func (q *_TaskQueue) setRunning(running bool) bool {
+	if q.deque != nil {
+		return q.deque.setRunning(running)
+	}
+	return false
+}
+
+// out 返回频道实现的输出端；其他实现返回 nil。
 func (q *_TaskQueue) out() <-chan _Task {
 	if q.boundedChan != nil {
 		return q.boundedChan
@@ -182,6 +393,7 @@ func (q *_TaskQueue) out() <-chan _Task {
 func (q *_TaskQueue) start(typ TaskType) {
 	q.stats[typ].queued.Add(-1)
 	q.stats[typ].running.Add(1)
+	q.release()
 }
 
 func (q *_TaskQueue) complete(typ TaskType, panicked bool) {
@@ -194,6 +406,9 @@ func (q *_TaskQueue) complete(typ TaskType, panicked bool) {
 
 func (q *_TaskQueue) close() {
 	q.barrier.Close()
+	if q.deque != nil {
+		q.deque.close()
+	}
 	q.barrier.Wait()
 	if q.boundedChan != nil {
 		close(q.boundedChan)
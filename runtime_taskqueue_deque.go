@@ -0,0 +1,222 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// _TaskDeque 是带锁的有界任务双端队列，用于支持除拒绝外的溢出策略。Frame 任务不受容量限制，也不会被丢弃。
+//
+// push 可在任意 goroutine 中调用；pop、ready 只能由 Runtime goroutine 调用。
+type _TaskDeque struct {
+	mu       sync.Mutex
+	buf      []_Task
+	head     int
+	n        int
+	capacity int
+	closed   bool
+	space    chan struct{} // 有生产者等待空位时创建，出队时关闭以唤醒全部等待者。
+	notify   chan struct{}
+	running  atomic.Bool // Runtime goroutine 正在执行任务、定时器或帧回调时为 true。
+}
+
+func (d *_TaskDeque) init(capacity int) {
+	d.buf = make([]_Task, capacity+1)
+	d.capacity = capacity
+	d.notify = make(chan struct{}, 1)
+}
+
+// setRunning 设置 Runtime goroutine 是否正在执行任务，返回先前的值，只能由 Runtime goroutine 调用。
+func (d *_TaskDeque) setRunning(running bool) bool {
+	return d.running.Swap(running)
+}
+
+// push 按 policy 写入任务。Runtime goroutine 正在执行任务时写入已满的队列，TaskQueueOverflow_Block 按拒绝处理。返回被挤出的任务；新任务被静默丢弃时 dropped 为 true。
+func (d *_TaskDeque) push(ctx context.Context, task _Task, policy TaskQueueOverflow, timeout time.Duration) (evicted []_Task, dropped bool, err error) {
+	var (
+		ctxDone  <-chan struct{}
+		timeoutC <-chan time.Time
+	)
+	if ctx != nil {
+		ctxDone = ctx.Done()
+	}
+
+	d.mu.Lock()
+	for {
+		if d.closed {
+			d.mu.Unlock()
+			return nil, false, ErrTaskQueueClosed
+		}
+
+		if d.n < d.capacity || task.typ == TaskType_Frame {
+			d.append(task)
+			d.mu.Unlock()
+			d.signal()
+			return evicted, false, nil
+		}
+
+		switch policy {
+		case TaskQueueOverflow_Block:
+			// Runtime goroutine 正在执行任务时无法出队，写入方可能就是它自身，阻塞可能死锁，按拒绝处理
+			if d.running.Load() {
+				break
+			}
+			if d.space == nil {
+				d.space = make(chan struct{})
+			}
+			space := d.space
+			d.mu.Unlock()
+
+			if timeoutC == nil && timeout > 0 {
+				timer := time.NewTimer(timeout)
+				defer timer.Stop()
+				timeoutC = timer.C
+			}
+
+			select {
+			case <-space:
+			case <-ctxDone:
+				return nil, false, fmt.Errorf("%w: %w", ErrTaskQueueFull, context.Cause(ctx))
+			case <-timeoutC:
+				return nil, false, ErrTaskQueueFull
+			}
+
+			d.mu.Lock()
+			continue
+
+		case TaskQueueOverflow_DropOldest:
+			if i := d.find(0, d.n, 1, func(queued *_Task) bool { return queued.typ == TaskType_Post }); i >= 0 {
+				evicted = append(evicted, d.remove(i))
+				continue
+			}
+
+		case TaskQueueOverflow_DropNewest:
+			if task.typ == TaskType_Post {
+				d.mu.Unlock()
+				return nil, true, nil
+			}
+
+		case TaskQueueOverflow_Priority:
+			priority := taskPriority(task.typ)
+			if i := d.find(d.n-1, -1, -1, func(queued *_Task) bool { return taskPriority(queued.typ) < priority }); i >= 0 {
+				evicted = append(evicted, d.remove(i))
+				continue
+			}
+		}
+
+		d.mu.Unlock()
+		return evicted, false, ErrTaskQueueFull
+	}
+}
+
+// pop 取出队首任务，并唤醒等待空位的生产者。
+func (d *_TaskDeque) pop() (_Task, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.n <= 0 {
+		return _Task{}, false
+	}
+	task := d.buf[d.head]
+	d.buf[d.head] = _Task{}
+	d.head = (d.head + 1) % len(d.buf)
+	d.n--
+
+	if d.space != nil {
+		close(d.space)
+		d.space = nil
+	}
+	return task, true
+}
+
+// ready 返回有任务可取时可读的频道。
+func (d *_TaskDeque) ready() <-chan struct{} {
+	d.mu.Lock()
+	n := d.n
+	d.mu.Unlock()
+
+	if n > 0 {
+		d.signal()
+	}
+	return d.notify
+}
+
+// close 拒绝后续写入，并唤醒全部等待空位的生产者。
+func (d *_TaskDeque) close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.closed = true
+	if d.space != nil {
+		close(d.space)
+		d.space = nil
+	}
+}
+
+func (d *_TaskDeque) signal() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (d *_TaskDeque) append(task _Task) {
+	d.buf[(d.head+d.n)%len(d.buf)] = task
+	d.n++
+}
+
+// find 从第 from 个任务起按 step 查找满足 fn 的任务，返回其序号，未找到时返回 -1。
+func (d *_TaskDeque) find(from, to, step int, fn func(*_Task) bool) int {
+	for i := from; i != to; i += step {
+		if fn(&d.buf[(d.head+i)%len(d.buf)]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// remove 移除第 i 个任务，后续任务依次前移。
+func (d *_TaskDeque) remove(i int) _Task {
+	size := len(d.buf)
+	task := d.buf[(d.head+i)%size]
+	for ; i < d.n-1; i++ {
+		d.buf[(d.head+i)%size] = d.buf[(d.head+i+1)%size]
+	}
+	d.buf[(d.head+d.n-1)%size] = _Task{}
+	d.n--
+	return task
+}
+
+// taskPriority 返回任务在 TaskQueueOverflow_Priority 策略中的优先级，数值越大越优先保留。
+func taskPriority(typ TaskType) int {
+	switch typ {
+	case TaskType_Frame:
+		return 2
+	case TaskType_Submit:
+		return 1
+	default:
+		return 0
+	}
+}
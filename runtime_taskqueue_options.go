@@ -20,14 +20,49 @@
 package core
 
 import (
+	"time"
+
 	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/generic"
 	"git.golaxy.org/core/utils/option"
 )
 
+// TaskQueueBackend 标识任务队列的实现。
+type TaskQueueBackend int8
+
+const (
+	TaskQueueBackend_Channel TaskQueueBackend = iota // Go 频道；无界模式由一个转发 goroutine 驱动。
+	TaskQueueBackend_Ring                            // 无锁多生产者单消费者环形队列；无界模式溢出到分段链表，不需要转发 goroutine。
+)
+
+// TaskQueueOverflow 标识有界任务队列已满时的处理策略。
+type TaskQueueOverflow int8
+
+const (
+	TaskQueueOverflow_Reject     TaskQueueOverflow = iota // 拒绝新任务，返回 ErrTaskQueueFull。
+	TaskQueueOverflow_Block                               // 阻塞生产者，直到有空位、队列关闭、BlockTimeout 超时或调用方 ctx 结束；Runtime goroutine 正在执行任务、定时器或帧回调时按拒绝处理，避免其向自身队列写入时死锁。
+	TaskQueueOverflow_DropOldest                          // 丢弃队列中最早的 Post 任务；队列中没有 Post 任务时拒绝。
+	TaskQueueOverflow_DropNewest                          // 静默丢弃新的 Post 任务；新任务不是 Post 时拒绝。
+	TaskQueueOverflow_Priority                            // 按 Frame > Submit > Post 的优先级丢弃队列中最新的低优先级任务；没有更低优先级的任务时拒绝。
+)
+
+type (
+	TaskQueueWatermarkCB = generic.Action3[Runtime, bool, int] // 任务积压越过水位时的回调；high 为 true 表示达到高水位，为 false 表示回落到低水位，depth 为当时的积压数。
+)
+
 // TaskQueueOptions 定义运行时任务队列的容量策略。
 type TaskQueueOptions struct {
-	Unbounded bool // 是否使用无界队列。
-	Capacity  int  // 有界队列容量；使用无界队列时忽略。
+	Backend       TaskQueueBackend     // 队列实现。
+	Unbounded     bool                 // 是否使用无界队列。
+	Capacity      int                  // 有界队列容量；使用无界队列时忽略，Ring 实现中为环形缓冲区大小。有界 Ring 队列要求为不小于 2 的 2 的幂，否则创建运行时时 panic；无界 Ring 队列向上取整为 2 的幂。
+	BatchSize     int                  // 每次唤醒最多连续执行的任务数，1 表示逐个执行。
+	BatchTime     time.Duration        // 每次唤醒连续执行任务的时间上限，0 表示不限制。
+	BatchEvents   bool                 // 是否每批只发送一次 RunCallBegin/RunCallEnd。
+	Overflow      TaskQueueOverflow    // 有界队列已满时的处理策略；使用无界队列时忽略。有界 Ring 队列只支持 TaskQueueOverflow_Reject，否则创建运行时时 panic。
+	BlockTimeout  time.Duration        // TaskQueueOverflow_Block 的最长等待时间，0 表示不限制。
+	LowWatermark  int                  // 积压回落到该值时调用 WatermarkCB。
+	HighWatermark int                  // 积压达到该值时调用 WatermarkCB，0 表示不启用水位回调。
+	WatermarkCB   TaskQueueWatermarkCB // 积压越过水位时的回调。
 }
 
 type _TaskQueueOption struct{}
@@ -35,8 +70,28 @@ type _TaskQueueOption struct{}
 // Default 返回任务队列选项的默认设置。
 func (_TaskQueueOption) Default() option.Setting[TaskQueueOptions] {
 	return func(options *TaskQueueOptions) {
+		With.TaskQueue.Backend(TaskQueueBackend_Channel).Apply(options)
 		With.TaskQueue.Unbounded(true).Apply(options)
 		With.TaskQueue.Capacity(128).Apply(options)
+		With.TaskQueue.BatchSize(1).Apply(options)
+		With.TaskQueue.BatchTime(0).Apply(options)
+		With.TaskQueue.BatchEvents(false).Apply(options)
+		With.TaskQueue.Overflow(TaskQueueOverflow_Reject).Apply(options)
+		With.TaskQueue.BlockTimeout(0).Apply(options)
+		With.TaskQueue.Watermarks(0, 0).Apply(options)
+		With.TaskQueue.WatermarkCB(nil).Apply(options)
+	}
+}
+
+// Backend 设置队列实现。
+func (_TaskQueueOption) Backend(backend TaskQueueBackend) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		switch backend {
+		case TaskQueueBackend_Channel, TaskQueueBackend_Ring:
+		default:
+			exception.Panicf("%w: %w: invalid Backend", ErrRuntime, exception.ErrArgs)
+		}
+		options.Backend = backend
 	}
 }
 
@@ -47,7 +102,7 @@ func (_TaskQueueOption) Unbounded(b bool) option.Setting[TaskQueueOptions] {
 	}
 }
 
-// Capacity 设置队列容量，cap 必须大于 0。
+// Capacity 设置队列容量，cap 必须大于 0；有界 Ring 队列还要求 cap 为不小于 2 的 2 的幂。
 func (_TaskQueueOption) Capacity(cap int) option.Setting[TaskQueueOptions] {
 	return func(options *TaskQueueOptions) {
 		if cap <= 0 {
@@ -56,3 +111,73 @@ func (_TaskQueueOption) Capacity(cap int) option.Setting[TaskQueueOptions] {
 		options.Capacity = cap
 	}
 }
+
+// BatchSize 设置每次唤醒最多连续执行的任务数，n 必须大于 0。
+// 大于 1 时 Runtime 取出一个任务后继续非阻塞地排空队列，减少逐个 select 的开销。
+func (_TaskQueueOption) BatchSize(n int) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		if n <= 0 {
+			exception.Panicf("%w: %w: BatchSize must be greater than 0", ErrRuntime, exception.ErrArgs)
+		}
+		options.BatchSize = n
+	}
+}
+
+// BatchTime 设置每次唤醒连续执行任务的时间上限，dur 不能小于 0，0 表示只受 BatchSize 限制。
+func (_TaskQueueOption) BatchTime(dur time.Duration) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		if dur < 0 {
+			exception.Panicf("%w: %w: BatchTime must be greater than or equal to 0", ErrRuntime, exception.ErrArgs)
+		}
+		options.BatchTime = dur
+	}
+}
+
+// BatchEvents 设置是否每批只发送一次 RunCallBegin/RunCallEnd，而不是每个 Submit/Post 任务各发送一次。
+func (_TaskQueueOption) BatchEvents(b bool) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		options.BatchEvents = b
+	}
+}
+
+// Overflow 设置有界队列已满时的处理策略。除 TaskQueueOverflow_Reject 外的策略使用带锁的双端队列代替 Channel 实现；
+// 有界 Ring 队列只支持 TaskQueueOverflow_Reject，与其他策略组合时创建运行时时 panic。
+func (_TaskQueueOption) Overflow(policy TaskQueueOverflow) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		switch policy {
+		case TaskQueueOverflow_Reject, TaskQueueOverflow_Block, TaskQueueOverflow_DropOldest, TaskQueueOverflow_DropNewest, TaskQueueOverflow_Priority:
+		default:
+			exception.Panicf("%w: %w: invalid Overflow", ErrRuntime, exception.ErrArgs)
+		}
+		options.Overflow = policy
+	}
+}
+
+// BlockTimeout 设置 TaskQueueOverflow_Block 的最长等待时间，dur 不能小于 0，0 表示等待到有空位或队列关闭。
+func (_TaskQueueOption) BlockTimeout(dur time.Duration) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		if dur < 0 {
+			exception.Panicf("%w: %w: BlockTimeout must be greater than or equal to 0", ErrRuntime, exception.ErrArgs)
+		}
+		options.BlockTimeout = dur
+	}
+}
+
+// Watermarks 设置积压的低水位与高水位，high 为 0 时不启用水位回调，否则必须满足 0 <= low < high。
+func (_TaskQueueOption) Watermarks(low, high int) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		if high != 0 && (low < 0 || low >= high) {
+			exception.Panicf("%w: %w: Watermarks must satisfy 0 <= low < high", ErrRuntime, exception.ErrArgs)
+		}
+		options.LowWatermark = low
+		options.HighWatermark = high
+	}
+}
+
+// WatermarkCB 设置积压越过水位时的回调。回调在触发水位切换的生产者或 Runtime goroutine 中调用，按切换顺序串行投递、
+// 不会并发，高低水位回调总是交替出现；投递前已经相互抵消的一对切换不再回调。回调应快速返回。
+func (_TaskQueueOption) WatermarkCB(cb TaskQueueWatermarkCB) option.Setting[TaskQueueOptions] {
+	return func(options *TaskQueueOptions) {
+		options.WatermarkCB = cb
+	}
+}
@@ -0,0 +1,200 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"math/bits"
+	"sync/atomic"
+)
+
+// taskRingSegmentSize 是溢出分段链表中每个分段的任务数。
+const taskRingSegmentSize = 256
+
+type _TaskRingCell struct {
+	seq  atomic.Uint64
+	task _Task
+}
+
+type _TaskRingSegment struct {
+	next    atomic.Pointer[_TaskRingSegment]
+	reserve atomic.Int64
+	ready   [taskRingSegmentSize]atomic.Bool
+	tasks   [taskRingSegmentSize]_Task
+}
+
+// _TaskRing 是无锁多生产者单消费者任务队列。任务优先写入定长环形缓冲区；无界模式下环满后写入溢出分段链表，
+// 直到溢出任务全部被消费前，新任务都进入溢出链表，以保持同一生产者的先后顺序。消费时环形缓冲区中的任务
+// 先于溢出任务，环头已被预留但尚未发布时等待其发布，不会越过它取出更晚写入的溢出任务。
+//
+// push 可在任意 goroutine 中调用；pop、ready 只能由 Runtime goroutine 调用。
+type _TaskRing struct {
+	tail        atomic.Uint64
+	overflowLen atomic.Int64
+	waiting     atomic.Bool
+	_           [64]byte // 隔离生产者与消费者频繁写入的字段。
+
+	head         uint64
+	overflowHead *_TaskRingSegment
+	overflowPos  int64
+
+	cells        []_TaskRingCell
+	mask         uint64
+	unbounded    bool
+	overflowTail atomic.Pointer[_TaskRingSegment]
+	notify       chan struct{}
+}
+
+// init 创建环形缓冲区，大小为不小于 capacity 的 2 的幂；有界模式下 capacity 必须已是 2 的幂。
+func (r *_TaskRing) init(unbounded bool, capacity int) {
+	size := uint64(1) << bits.Len(uint(max(capacity, 2)-1))
+	r.cells = make([]_TaskRingCell, size)
+	for i := range r.cells {
+		r.cells[i].seq.Store(uint64(i))
+	}
+	r.mask = size - 1
+	r.unbounded = unbounded
+	r.overflowHead = &_TaskRingSegment{}
+	r.overflowTail.Store(r.overflowHead)
+	r.notify = make(chan struct{}, 1)
+}
+
+// push 写入任务；有界模式下环满且未设置 force 时返回 false。
+func (r *_TaskRing) push(task _Task, force bool) bool {
+	if (!r.unbounded || r.overflowLen.Load() <= 0) && r.pushRing(task) {
+		r.signal()
+		return true
+	}
+	if !r.unbounded && !force {
+		return false
+	}
+	r.pushOverflow(task)
+	r.signal()
+	return true
+}
+
+func (r *_TaskRing) pushRing(task _Task) bool {
+	pos := r.tail.Load()
+	for {
+		cell := &r.cells[pos&r.mask]
+		switch seq := cell.seq.Load(); {
+		case seq == pos:
+			if r.tail.CompareAndSwap(pos, pos+1) {
+				cell.task = task
+				cell.seq.Store(pos + 1)
+				return true
+			}
+			pos = r.tail.Load()
+		case seq < pos:
+			return false
+		default:
+			pos = r.tail.Load()
+		}
+	}
+}
+
+func (r *_TaskRing) pushOverflow(task _Task) {
+	r.overflowLen.Add(1)
+	for {
+		seg := r.overflowTail.Load()
+		if idx := seg.reserve.Add(1) - 1; idx < taskRingSegmentSize {
+			seg.tasks[idx] = task
+			seg.ready[idx].Store(true)
+			return
+		}
+		if seg.next.Load() == nil {
+			seg.next.CompareAndSwap(nil, &_TaskRingSegment{})
+		}
+		r.overflowTail.CompareAndSwap(seg, seg.next.Load())
+	}
+}
+
+// pop 取出一个已发布的任务，环形缓冲区优先；环形缓冲区排空后才取溢出任务。
+func (r *_TaskRing) pop() (_Task, bool) {
+	if cell := &r.cells[r.head&r.mask]; cell.seq.Load() == r.head+1 {
+		task := cell.task
+		cell.task = _Task{}
+		cell.seq.Store(r.head + r.mask + 1)
+		r.head++
+		return task, true
+	}
+	if r.reserved() {
+		return _Task{}, false
+	}
+
+	seg, pos, ok := r.peekOverflow()
+	if !ok {
+		return _Task{}, false
+	}
+	task := seg.tasks[pos]
+	seg.tasks[pos] = _Task{}
+	r.overflowHead = seg
+	r.overflowPos = pos + 1
+	r.overflowLen.Add(-1)
+	return task, true
+}
+
+func (r *_TaskRing) peekOverflow() (*_TaskRingSegment, int64, bool) {
+	seg, pos := r.overflowHead, r.overflowPos
+	if pos >= taskRingSegmentSize {
+		if seg = seg.next.Load(); seg == nil {
+			return nil, 0, false
+		}
+		pos = 0
+	}
+	return seg, pos, seg.ready[pos].Load()
+}
+
+// reserved 报告环头是否已被生产者预留但尚未发布。
+func (r *_TaskRing) reserved() bool {
+	return r.tail.Load() != r.head
+}
+
+func (r *_TaskRing) published() bool {
+	if r.cells[r.head&r.mask].seq.Load() == r.head+1 {
+		return true
+	}
+	if r.reserved() {
+		return false
+	}
+	_, _, ok := r.peekOverflow()
+	return ok
+}
+
+// ready 返回有任务可取时可读的频道。调用后若队列为空，下一个发布任务的生产者负责唤醒。
+func (r *_TaskRing) ready() <-chan struct{} {
+	r.waiting.Store(true)
+	if r.published() {
+		r.waiting.Store(false)
+		select {
+		case r.notify <- struct{}{}:
+		default:
+		}
+	}
+	return r.notify
+}
+
+func (r *_TaskRing) signal() {
+	if r.waiting.Load() && r.waiting.CompareAndSwap(true, false) {
+		select {
+		case r.notify <- struct{}{}:
+		default:
+		}
+	}
+}
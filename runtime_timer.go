@@ -0,0 +1,362 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"container/heap"
+	"context"
+	"time"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/generic"
+)
+
+// _WheelTarget 是时间轮条目的持有者。
+type _WheelTarget interface {
+	// expire 在 Runtime goroutine 中处理到期。
+	expire(s *_TimerService, now int64)
+	// abort 在时间轮关闭时处理未到期条目。
+	abort(s *_TimerService, err error)
+}
+
+type _Timer struct {
+	_WheelEntry
+	service   *_TimerService
+	owner     any
+	fn        func()
+	interval  time.Duration
+	byFrames  bool
+	due       int64 // 帧定时器的目标帧数。
+	remaining int64 // 暂停时剩余的纳秒数或帧数。
+	index     int
+	paused    bool
+	stopped   bool
+}
+
+func (timer *_Timer) expire(s *_TimerService, now int64) {
+	s.fire(timer, now)
+}
+
+func (timer *_Timer) abort(*_TimerService, error) {
+	timer.stopped = true
+}
+
+// _AfterTimer 以当前时间完成 After 返回的 Future。
+type _AfterTimer struct {
+	_WheelEntry
+	promise async.Promise
+}
+
+func (timer *_AfterTimer) expire(_ *_TimerService, now int64) {
+	timer.promise.Resolve(async.NewResult(time.Unix(0, now), nil))
+}
+
+func (timer *_AfterTimer) abort(_ *_TimerService, err error) {
+	timer.promise.Resolve(async.NewResult(nil, err))
+}
+
+// _EveryTimer 向 Every 返回的 Stream 周期产出当前时间；Stream 只缓存 1 个 tick，消费者跟不上时丢弃新的 tick。
+type _EveryTimer struct {
+	_WheelEntry
+	emitter  async.Emitter
+	interval int64
+}
+
+func (timer *_EveryTimer) expire(s *_TimerService, now int64) {
+	timer.emitter.TryEmit(async.NewResult(time.Unix(0, now), nil))
+	timer.deadline = nextDeadline(timer.deadline, timer.interval, now)
+	if !s.wheel.add(&timer._WheelEntry) {
+		timer.emitter.Close()
+	}
+}
+
+func (timer *_EveryTimer) abort(*_TimerService, error) {
+	timer.emitter.Close()
+}
+
+type _TimerHeap []*_Timer
+
+func (h _TimerHeap) Len() int           { return len(h) }
+func (h _TimerHeap) Less(i, j int) bool { return h[i].due < h[j].due }
+func (h _TimerHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *_TimerHeap) Push(x any) {
+	timer := x.(*_Timer)
+	timer.index = len(*h)
+	*h = append(*h, timer)
+}
+func (h *_TimerHeap) Pop() any {
+	old := *h
+	n := len(old)
+	timer := old[n-1]
+	old[n-1] = nil
+	timer.index = -1
+	*h = old[:n-1]
+	return timer
+}
+
+// _TimerService 保存 Runtime 的定时器。时间定时器由时间轮驱动，帧定时器按目标帧数保存在最小堆中。
+//
+// 时间轮允许任意 goroutine 插入 After、Every 条目；其余状态只在 Runtime goroutine 中访问。
+type _TimerService struct {
+	rt       *RuntimeBehavior
+	wheel    _TimingWheel
+	byFrames _TimerHeap
+	owners   map[any]map[*_Timer]struct{}
+	timer    *time.Timer
+	armed    int64 // timer 计划触发的 UnixNano 时间，0 表示未计划。
+	closed   bool
+}
+
+func (s *_TimerService) init(rt *RuntimeBehavior, tick time.Duration) {
+	s.rt = rt
+	s.wheel.init(tick)
+	s.owners = map[any]map[*_Timer]struct{}{}
+	s.timer = time.NewTimer(tick)
+	s.timer.Stop()
+}
+
+// C 返回在时间轮下一个非空槽位到期时可读的频道；时间轮为空时停止计时并返回 nil。
+func (s *_TimerService) C() <-chan time.Time {
+	next, ok := s.wheel.next()
+	if !ok {
+		if s.armed != 0 {
+			s.timer.Stop()
+			s.armed = 0
+		}
+		return nil
+	}
+	if next != s.armed {
+		s.timer.Reset(time.Duration(next - time.Now().UnixNano()))
+		s.armed = next
+	}
+	return s.timer.C
+}
+
+// Wake 返回插入的条目早于计划唤醒时间时可读的频道，用于唤醒 Runtime 重新计时。
+func (s *_TimerService) Wake() <-chan struct{} {
+	return s.wheel.wake
+}
+
+func (s *_TimerService) add(owner any, fn func(), interval time.Duration, byFrames bool, after int64, paused bool) *_Timer {
+	timer := &_Timer{
+		service:  s,
+		owner:    owner,
+		fn:       fn,
+		interval: interval,
+		byFrames: byFrames,
+		index:    -1,
+	}
+	timer.target = timer
+	if s.closed {
+		timer.stopped = true
+		return timer
+	}
+
+	timers := s.owners[owner]
+	if timers == nil {
+		timers = map[*_Timer]struct{}{}
+		s.owners[owner] = timers
+	}
+	timers[timer] = struct{}{}
+
+	if paused {
+		timer.paused = true
+		timer.remaining = after
+	} else {
+		s.schedule(timer, after)
+	}
+	return timer
+}
+
+// after 在时间轮中登记 After 条目；时间轮已关闭时返回 false。
+func (s *_TimerService) after(promise async.Promise, dur time.Duration) bool {
+	timer := &_AfterTimer{promise: promise}
+	timer.target = timer
+	timer.deadline = time.Now().UnixNano() + int64(dur)
+	return s.wheel.add(&timer._WheelEntry)
+}
+
+// every 在时间轮中登记 Every 条目；时间轮已关闭时返回 false。
+func (s *_TimerService) every(emitter async.Emitter, interval time.Duration) bool {
+	timer := &_EveryTimer{emitter: emitter, interval: int64(interval)}
+	timer.target = timer
+	timer.deadline = time.Now().UnixNano() + int64(interval)
+	return s.wheel.add(&timer._WheelEntry)
+}
+
+func (s *_TimerService) schedule(timer *_Timer, after int64) {
+	if timer.byFrames {
+		timer.due = s.curFrames() + after
+		heap.Push(&s.byFrames, timer)
+	} else {
+		timer.deadline = time.Now().UnixNano() + after
+		s.wheel.add(&timer._WheelEntry)
+	}
+}
+
+func (s *_TimerService) unschedule(timer *_Timer) {
+	if timer.byFrames {
+		if timer.index >= 0 {
+			heap.Remove(&s.byFrames, timer.index)
+		}
+	} else {
+		s.wheel.remove(&timer._WheelEntry)
+	}
+}
+
+func (s *_TimerService) stop(timer *_Timer) bool {
+	if timer.stopped {
+		return false
+	}
+	timer.stopped = true
+	s.unschedule(timer)
+	if timers := s.owners[timer.owner]; timers != nil {
+		delete(timers, timer)
+		if len(timers) <= 0 {
+			delete(s.owners, timer.owner)
+		}
+	}
+	return true
+}
+
+// pauseOwner 暂停 owner 的全部定时器，保留剩余时长或帧数。
+func (s *_TimerService) pauseOwner(owner any) {
+	timers := s.owners[owner]
+	if len(timers) <= 0 {
+		return
+	}
+	now, frames := time.Now().UnixNano(), s.curFrames()
+	for timer := range timers {
+		if timer.paused {
+			continue
+		}
+		s.unschedule(timer)
+		timer.paused = true
+		if timer.byFrames {
+			timer.remaining = max(timer.due-frames, 0)
+		} else {
+			timer.remaining = max(timer.deadline-now, 0)
+		}
+	}
+}
+
+// resumeOwner 恢复 owner 的全部已暂停定时器。
+func (s *_TimerService) resumeOwner(owner any) {
+	for timer := range s.owners[owner] {
+		if !timer.paused {
+			continue
+		}
+		timer.paused = false
+		s.schedule(timer, timer.remaining)
+	}
+}
+
+// stopOwner 停止 owner 的全部定时器。
+func (s *_TimerService) stopOwner(owner any) {
+	for timer := range s.owners[owner] {
+		s.stop(timer)
+	}
+}
+
+// stopAll 停止全部定时器并关闭时间轮，未到期的 After 以 ctx 的取消原因完成，Every 的 Stream 关闭。
+func (s *_TimerService) stopAll() {
+	s.closed = true
+	for owner := range s.owners {
+		s.stopOwner(owner)
+	}
+
+	err := context.Cause(s.rt.ctx)
+	if err == nil {
+		err = context.Canceled
+	}
+	for _, entry := range s.wheel.close() {
+		entry.target.abort(s, err)
+	}
+
+	s.timer.Stop()
+	s.armed = 0
+}
+
+// runTime 批量执行时间轮中已到期的条目。
+func (s *_TimerService) runTime() {
+	now := time.Now().UnixNano()
+	for _, entry := range s.wheel.advance(now) {
+		entry.target.expire(s, now)
+	}
+}
+
+// runFrames 执行所有已到达目标帧的帧定时器。
+func (s *_TimerService) runFrames() {
+	frames := s.curFrames()
+	for len(s.byFrames) > 0 && s.byFrames[0].due <= frames {
+		timer := heap.Pop(&s.byFrames).(*_Timer)
+		s.fire(timer, frames)
+	}
+}
+
+func (s *_TimerService) fire(timer *_Timer, now int64) {
+	if timer.stopped || timer.paused {
+		return
+	}
+	if !timerOwnerAlive(timer.owner) {
+		s.stop(timer)
+		return
+	}
+
+	if timer.interval > 0 {
+		timer.deadline = nextDeadline(timer.deadline, int64(timer.interval), now)
+		s.wheel.add(&timer._WheelEntry)
+	} else {
+		s.stop(timer)
+	}
+
+	generic.CastAction0(timer.fn).Call(s.rt.ctx.AutoRecover(), s.rt.ctx.PanicReporter())
+}
+
+func (s *_TimerService) curFrames() int64 {
+	if s.rt.frame == nil {
+		return 0
+	}
+	return s.rt.frame.CurFrames()
+}
+
+// nextDeadline 返回周期定时器的下一个截止时间；落后超过一个周期时跳过错过的触发。
+func nextDeadline(deadline, interval, now int64) int64 {
+	next := deadline + interval
+	if next <= now {
+		next = now + interval
+	}
+	return next
+}
+
+func timerOwnerAlive(owner any) bool {
+	switch owner := owner.(type) {
+	case ec.Component:
+		return owner.State() < ec.ComponentState_Detaching
+	case ec.Entity:
+		return owner.State() < ec.EntityState_Leaving
+	}
+	return true
+}
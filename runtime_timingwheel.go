@@ -0,0 +1,261 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	timingWheelBits   = 6
+	timingWheelSlots  = 1 << timingWheelBits
+	timingWheelMask   = timingWheelSlots - 1
+	timingWheelLevels = 5
+	timingWheelSpan   = int64(1) << (timingWheelBits * timingWheelLevels)
+)
+
+type _WheelEntry struct {
+	prev, next *_WheelEntry
+	list       *_WheelList
+	target     _WheelTarget
+	deadline   int64 // UnixNano 截止时间。
+}
+
+// _WheelList 是时间轮槽位中的侵入式双向链表，插入与删除均为 O(1)。
+type _WheelList struct {
+	head *_WheelEntry
+}
+
+func (l *_WheelList) push(e *_WheelEntry) {
+	e.list = l
+	e.prev = nil
+	e.next = l.head
+	if l.head != nil {
+		l.head.prev = e
+	}
+	l.head = e
+}
+
+func (l *_WheelList) remove(e *_WheelEntry) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		l.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	}
+	e.prev, e.next, e.list = nil, nil, nil
+}
+
+func (l *_WheelList) take() *_WheelEntry {
+	head := l.head
+	l.head = nil
+	return head
+}
+
+// _TimingWheel 是分层哈希时间轮：每层 64 个槽位，共 5 层，覆盖 64^5 个刻度；更远的条目暂存在最高层，
+// 降级时按截止时间重新定位。插入与取消为 O(1)，每个刻度批量取出到期条目。
+//
+// 插入与取消可在任意 goroutine 中进行；推进只由 Runtime goroutine 执行。Runtime 只在下一个非空槽位的刻度唤醒，
+// 插入的条目早于该刻度时通过 wake 通知 Runtime 重新计算。
+type _TimingWheel struct {
+	mu      sync.Mutex
+	tick    int64
+	start   int64
+	cur     int64 // 下一个待处理的刻度。
+	levels  [timingWheelLevels][timingWheelSlots]_WheelList
+	count   atomic.Int64
+	closed  bool
+	armed   int64 // Runtime 计划唤醒的 UnixNano 时间，0 表示未计划。
+	changed bool  // 槽位自上次计算 armed 后是否有变化。
+	wake    chan struct{}
+	expired []*_WheelEntry
+}
+
+func (w *_TimingWheel) init(tick time.Duration) {
+	w.tick = int64(tick)
+	w.start = time.Now().UnixNano()
+	w.wake = make(chan struct{}, 1)
+}
+
+// add 插入条目；时间轮已关闭时返回 false。
+func (w *_TimingWheel) add(e *_WheelEntry) bool {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return false
+	}
+	due := w.place(e)
+	w.count.Add(1)
+	w.changed = true
+	earlier := w.armed == 0 || w.start+due*w.tick < w.armed
+	w.mu.Unlock()
+
+	if earlier {
+		select {
+		case w.wake <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+// remove 取消尚未到期的条目；条目已到期或已取消时返回 false。
+func (w *_TimingWheel) remove(e *_WheelEntry) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if e.list == nil {
+		return false
+	}
+	e.list.remove(e)
+	w.count.Add(-1)
+	w.changed = true
+	return true
+}
+
+// advance 推进到 now 所在刻度，返回本次到期的条目；返回的切片在下次推进前有效。
+func (w *_TimingWheel) advance(now int64) []*_WheelEntry {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.expired = w.expired[:0]
+	w.changed = true
+	target := (now - w.start) / w.tick
+
+	for w.cur <= target {
+		if w.count.Load() <= 0 {
+			w.cur = target + 1
+			break
+		}
+		idx := w.cur & timingWheelMask
+		for level := 1; idx == 0 && level < timingWheelLevels; level++ {
+			idx = w.cascade(level)
+		}
+		for e := w.levels[0][w.cur&timingWheelMask].take(); e != nil; {
+			next := e.next
+			e.prev, e.next, e.list = nil, nil, nil
+			w.expired = append(w.expired, e)
+			w.count.Add(-1)
+			e = next
+		}
+		w.cur++
+	}
+	return w.expired
+}
+
+// next 返回下一个需要推进的刻度对应的 UnixNano 时间，即最近的非空槽位到期或高层槽位降级的时间，
+// 并记录为计划唤醒时间；时间轮为空时返回 false。
+func (w *_TimingWheel) next() (int64, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.changed {
+		return w.armed, w.armed != 0
+	}
+	w.changed = false
+	w.armed = 0
+	if w.count.Load() <= 0 {
+		return 0, false
+	}
+
+	due := int64(-1)
+	for i := int64(0); i < timingWheelSlots; i++ {
+		if w.levels[0][(w.cur+i)&timingWheelMask].head != nil {
+			due = w.cur + i
+			break
+		}
+	}
+	for level := 1; level < timingWheelLevels; level++ {
+		span := int64(1) << (timingWheelBits * level)
+		boundary := (w.cur + span - 1) / span * span
+		for i := int64(0); i < timingWheelSlots; i++ {
+			at := boundary + i*span
+			if due >= 0 && at >= due {
+				break
+			}
+			if w.levels[level][(at>>(timingWheelBits*level))&timingWheelMask].head != nil {
+				due = at
+				break
+			}
+		}
+	}
+	if due < 0 {
+		return 0, false
+	}
+
+	w.armed = w.start + due*w.tick
+	return w.armed, true
+}
+
+// close 关闭时间轮并取出全部未到期条目。
+func (w *_TimingWheel) close() []*_WheelEntry {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+	w.changed = true
+	var entries []*_WheelEntry
+	for level := range w.levels {
+		for slot := range w.levels[level] {
+			for e := w.levels[level][slot].take(); e != nil; {
+				next := e.next
+				e.prev, e.next, e.list = nil, nil, nil
+				entries = append(entries, e)
+				e = next
+			}
+		}
+	}
+	w.count.Store(0)
+	return entries
+}
+
+// cascade 将 level 层当前槽位中的条目重新定位到低层，返回该槽位下标。
+func (w *_TimingWheel) cascade(level int) int64 {
+	idx := (w.cur >> (timingWheelBits * level)) & timingWheelMask
+	for e := w.levels[level][idx].take(); e != nil; {
+		next := e.next
+		w.place(e)
+		e = next
+	}
+	return idx
+}
+
+// place 将条目放入对应槽位，返回其到期刻度。
+func (w *_TimingWheel) place(e *_WheelEntry) int64 {
+	due := (e.deadline - w.start + w.tick - 1) / w.tick
+	if due < w.cur {
+		due = w.cur
+	}
+	delta := due - w.cur
+	if delta >= timingWheelSpan {
+		due = w.cur + timingWheelSpan - 1
+		delta = timingWheelSpan - 1
+	}
+
+	level := 0
+	for delta >= int64(1)<<(timingWheelBits*(level+1)) {
+		level++
+	}
+	w.levels[level][(due>>(timingWheelBits*level))&timingWheelMask].push(e)
+	return due
+}
@@ -0,0 +1,164 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"time"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/exception"
+)
+
+// SuspendUpdate 停止向组件派发 Update 与 LateUpdate，适用于长时间空闲的组件；暂停期间组件不占用帧更新开销。
+// 组件禁用后重新启用仍保持暂停，直到调用 ResumeUpdate。必须在组件所属 Runtime goroutine 中调用；已经暂停时返回 false。
+func SuspendUpdate(comp ec.Component) bool {
+	if comp == nil {
+		exception.Panicf("%w: %w: comp is nil", ErrCore, ErrArgs)
+	}
+	if ec.UnsafeComponent(comp).UpdateSuspended() {
+		return false
+	}
+	ec.UnsafeComponent(comp).SetUpdateSuspended(true)
+	ec.UnsafeComponent(comp).ManagedUnbindRuntimeHandles()
+	return true
+}
+
+// ResumeUpdate 恢复向组件派发 Update 与 LateUpdate，组件处于启用状态时从下一次更新开始生效。
+// 必须在组件所属 Runtime goroutine 中调用；未暂停时返回 false。
+func ResumeUpdate(comp ec.Component) bool {
+	if comp == nil {
+		exception.Panicf("%w: %w: comp is nil", ErrCore, ErrArgs)
+	}
+	if !ec.UnsafeComponent(comp).UpdateSuspended() {
+		return false
+	}
+	ec.UnsafeComponent(comp).SetUpdateSuspended(false)
+
+	if state := comp.State(); comp.Enabled() && state >= ec.ComponentState_Starting && state <= ec.ComponentState_Alive {
+		rt, ok := runtime.UnsafeContext(runtime.Current(comp)).Caller().(Runtime)
+		if !ok {
+			exception.Panicf("%w: runtime context is not bound to a runtime", ErrRuntime)
+		}
+		rt.observeComponent(comp)
+	}
+	return true
+}
+
+// updateInterval 返回组件的 Update 与 LateUpdate 节流间隔；运行时选项中的覆盖值优先于组件原型声明。
+func (rt *RuntimeBehavior) updateInterval(comp ec.Component) ec.UpdateInterval {
+	compPT := comp.Builtin().PT
+	if interval, ok := rt.options.UpdateIntervals[compPT.Prototype()]; ok {
+		return interval
+	}
+	return compPT.UpdateInterval()
+}
+
+// throttleComponent 按组件的节流间隔包装 Update 与 LateUpdate 订阅者。
+func (rt *RuntimeBehavior) throttleComponent(comp ec.Component, update eventUpdate, lateUpdate eventLateUpdate) (eventUpdate, eventLateUpdate) {
+	if rt.frame == nil {
+		return update, lateUpdate
+	}
+
+	interval := rt.updateInterval(comp)
+	if !interval.Throttled() {
+		return update, lateUpdate
+	}
+
+	throttled := &_ThrottledComponent{
+		frame:      rt.frame,
+		update:     update,
+		lateUpdate: lateUpdate,
+		interval:   interval,
+	}
+
+	// 按实体 ID 错开同一原型的更新帧，同一实体的组件保持同帧更新
+	stagger := updateStagger(comp.Entity().ID())
+	if interval.Duration > 0 {
+		throttled.next = time.Now().Add(time.Duration(stagger % uint64(interval.Duration)))
+	} else {
+		throttled.phase = int64(stagger % uint64(interval.Frames))
+	}
+
+	var throttledUpdate eventUpdate
+	if update != nil {
+		throttledUpdate = throttled
+	}
+	var throttledLateUpdate eventLateUpdate
+	if lateUpdate != nil {
+		throttledLateUpdate = throttled
+	}
+	return throttledUpdate, throttledLateUpdate
+}
+
+// updateStagger 返回实体 ID 的 FNV-1a 散列，用于错开节流更新。
+func updateStagger[T ~string](id T) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(id); i++ {
+		h ^= uint64(id[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+type _ThrottledComponent struct {
+	frame      *_Frame
+	update     eventUpdate
+	lateUpdate eventLateUpdate
+	interval   ec.UpdateInterval
+	phase      int64
+	next       time.Time
+	decided    int64 // 已判定的帧序号加 1，0 表示尚未判定。
+	due        bool
+}
+
+func (t *_ThrottledComponent) Update() {
+	if t.dueThisFrame() {
+		t.update.Update()
+	}
+}
+
+func (t *_ThrottledComponent) LateUpdate() {
+	if t.dueThisFrame() {
+		t.lateUpdate.LateUpdate()
+	}
+}
+
+// dueThisFrame 返回本帧是否需要更新；同一帧内 Update 与 LateUpdate 得到相同结果。
+func (t *_ThrottledComponent) dueThisFrame() bool {
+	frame := t.frame.CurFrames()
+	if t.decided == frame+1 {
+		return t.due
+	}
+	t.decided = frame + 1
+
+	if t.interval.Duration <= 0 {
+		t.due = (frame+t.phase)%t.interval.Frames == 0
+		return t.due
+	}
+
+	now := t.frame.UpdateBeginTime()
+	t.due = !now.Before(t.next)
+	if t.due {
+		// 落后超过一个间隔时跳过错过的更新，保持错开的相位
+		t.next = t.next.Add((now.Sub(t.next)/t.interval.Duration + 1) * t.interval.Duration)
+	}
+	return t.due
+}
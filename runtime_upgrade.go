@@ -0,0 +1,169 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/ec/pt"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/service"
+	"git.golaxy.org/core/utils/generic"
+)
+
+// upgradeRetryInterval 是任务队列已满时重新投递实体原型升级任务的间隔。
+const upgradeRetryInterval = 10 * time.Millisecond
+
+// watchEntityPT 订阅服务实体原型库，并将重新声明的原型投递到运行时 goroutine 升级存量实体。
+func (rt *RuntimeBehavior) watchEntityPT() {
+	ctx := rt.ctx
+
+	if !ctx.WaitGroup().Join(1) {
+		return
+	}
+
+	entityPTs := service.Current(ctx).EntityLib().Watch(ctx)
+
+	go func() {
+		defer ctx.WaitGroup().Done()
+
+		for entityPT := range entityPTs {
+			if entityPT.Version() <= 1 {
+				continue
+			}
+
+			for {
+				err := rt.getInstance().Post(func(runtime.Context, ...any) {
+					rt.upgradeEntities(entityPT)
+				})
+				if !errors.Is(err, ErrTaskQueueFull) {
+					break
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(upgradeRetryInterval):
+				}
+			}
+		}
+	}()
+}
+
+// upgradeEntities 将使用 entityPT 旧版本的全部存量实体升级到 entityPT。
+func (rt *RuntimeBehavior) upgradeEntities(entityPT ec.EntityPT) {
+	for _, entity := range rt.ctx.EntityManager().FilterEntities(func(entity ec.Entity) bool {
+		return entity.PT().Prototype() == entityPT.Prototype() && entity.PT().Version() < entityPT.Version()
+	}) {
+		rt.upgradeEntity(entity, entityPT)
+	}
+}
+
+// upgradeEntity 按 entityPT 调整实体的内建组件：不再声明或原型已改变的可删除内建组件被删除，
+// 仍声明的内建组件改为绑定新的描述，缺失的内建组件被创建并加入实体。
+// 删除与添加都经由组件管理器完成，Runtime 会按常规生命周期推进这些组件。
+// 已改变但不可删除的内建组件保持原样，并经 ReportError 上报。实体原型以原子方式替换，
+// 其他 goroutine 经 ConcurrentEntity 读取 PT 时观察到旧原型或新原型。
+func (rt *RuntimeBehavior) upgradeEntity(entity ec.Entity, entityPT ec.EntityPT) {
+	if entity.State() > ec.EntityState_Alive {
+		return
+	}
+
+	ec.UnsafeEntity(entity).SetPT(entityPT)
+
+	builtins := entityPT.ListComponents()
+	declared := make(map[string]int, len(builtins))
+	for i := range builtins {
+		declared[builtins[i].Name] = i
+	}
+
+	var removing []ec.Component
+
+	ec.UnsafeEntity(entity).ComponentList().TraversalEach(func(slot *generic.FreeSlot[ec.Component]) {
+		comp := slot.V
+
+		builtin := comp.Builtin()
+		if builtin.Offset < 0 {
+			return
+		}
+
+		if idx, ok := declared[comp.Name()]; ok && builtins[idx].PT == builtin.PT {
+			ec.UnsafeComponent(comp).SetBuiltin(&builtins[idx])
+			ec.UnsafeComponent(comp).SetRemovable(builtins[idx].Removable)
+			return
+		}
+
+		if !comp.Removable() {
+			rt.reportUpgradeError(fmt.Errorf("%w: entity %q upgrade to prototype %q version %d: builtin component %q is not removable, keeping component prototype %q",
+				ErrRuntime, entity.ID(), entityPT.Prototype(), entityPT.Version(), comp.Name(), builtin.PT.Prototype()))
+			return
+		}
+
+		removing = append(removing, comp)
+	})
+
+	for i := len(removing) - 1; i >= 0; i-- {
+		removing[i].Destroy()
+	}
+
+	for i := range builtins {
+		if entity.State() > ec.EntityState_Alive {
+			return
+		}
+
+		if rt.hasComponentNamed(entity, builtins[i].Name) {
+			continue
+		}
+
+		comp := entityPT.ConstructComponent(i)
+
+		if err := pt.BindComponentMeta(entity, comp); err != nil {
+			rt.reportUpgradeError(err)
+			continue
+		}
+
+		if err := entity.AddComponent(builtins[i].Name, comp); err != nil {
+			rt.reportUpgradeError(err)
+		}
+	}
+}
+
+// hasComponentNamed 报告实体是否仍有名为 name 的组件；查询不会触发首次访问 Awake。
+func (rt *RuntimeBehavior) hasComponentNamed(entity ec.Entity, name string) bool {
+	found := false
+	ec.UnsafeEntity(entity).ComponentList().Traversal(func(slot *generic.FreeSlot[ec.Component]) bool {
+		if slot.V.Name() == name && slot.V.State() <= ec.ComponentState_Alive {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func (rt *RuntimeBehavior) reportUpgradeError(err error) {
+	select {
+	case rt.ctx.ReportError() <- err:
+	default:
+	}
+}
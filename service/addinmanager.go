@@ -20,6 +20,7 @@
 package service
 
 import (
+	"log/slog"
 	"maps"
 	"reflect"
 	"slices"
@@ -46,6 +47,8 @@ type AddInManager interface {
 type iAddInManager interface {
 	freeze() []AddInStatus
 	getListStatuses() []AddInStatus
+	setLogger(logger *slog.Logger)
+	getLogger() *slog.Logger
 }
 
 // NewAddInManager 创建一个未冻结的空服务插件管理器。
@@ -60,6 +63,7 @@ func NewAddInManager() AddInManager {
 
 type _AddInManager struct {
 	snapshot atomic.Pointer[_AddInManagerSnapshot]
+	logger   atomic.Pointer[slog.Logger]
 }
 
 // _AddInManagerSnapshot 是插件集合的只读快照。
@@ -234,6 +238,17 @@ func (mgr *_AddInManager) getListStatuses() []AddInStatus {
 	return statuses
 }
 
+func (mgr *_AddInManager) setLogger(logger *slog.Logger) {
+	mgr.logger.Store(logger)
+}
+
+func (mgr *_AddInManager) getLogger() *slog.Logger {
+	if logger := mgr.logger.Load(); logger != nil {
+		return logger
+	}
+	return slog.New(slog.DiscardHandler)
+}
+
 // checkMutable 确认指定快照仍处于可修改阶段。
 func (mgr *_AddInManager) checkMutable(snapshot *_AddInManagerSnapshot) {
 	if snapshot.frozen {
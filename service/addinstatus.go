@@ -21,6 +21,7 @@ package service
 
 import (
 	"fmt"
+	"log/slog"
 	"reflect"
 	"sync/atomic"
 
@@ -76,6 +77,11 @@ func (s *_AddInStatus) State() extension.AddInState {
 	return extension.AddInState(s.state.Load())
 }
 
+// Logger 返回在服务日志记录器上附带 addin 属性的日志记录器。
+func (s *_AddInStatus) Logger() *slog.Logger {
+	return s.mgr.getLogger().With("addin", s.name)
+}
+
 // String 实现 fmt.Stringer，返回包含 ID、名称和实例类型的 JSON 文本。
 func (s *_AddInStatus) String() string {
 	if cached := s.stringerCache.Load(); cached != nil {
@@ -22,6 +22,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"reflect"
 	"sync/atomic"
 
@@ -35,7 +36,7 @@ import (
 )
 
 // NewContext 创建服务上下文。
-// 未提供父上下文、持久化 ID、原型库或插件管理器时会自动创建默认值。
+// 未提供父上下文、持久化 ID、原型库、插件管理器或实体目录时会自动创建默认值。
 func NewContext(settings ...option.Setting[ContextOptions]) Context {
 	return UnsafeNewContext(option.New(With.Default(), settings...))
 }
@@ -155,9 +156,26 @@ func (ctx *ContextBehavior) init(options ContextOptions) {
 		ctx.options.AddInManager = NewAddInManager()
 	}
 
-	corectx.UnsafeContext(&ctx.ContextBehavior).Init(ctx.options.Context, ctx.options.AutoRecover, ctx.options.ReportError)
+	if ctx.options.EntityDirectory == nil {
+		ctx.options.EntityDirectory = NewMemoryEntityDirectory()
+	}
+
+	corectx.UnsafeContext(&ctx.ContextBehavior).Init(ctx.options.Context, ctx.options.AutoRecover, ctx.options.ReportError, ctx.newLogger())
+	ctx.getAddInManager().setLogger(ctx.Logger())
 	ctx.reflected = reflect.ValueOf(ctx.getInstance())
-	ctx.entityManager.init(ctx.getInstance())
+	ctx.entityManager.init(ctx.getInstance(), ctx.options.EntityDirectory, ctx.options.EntityLeaseTTL)
+}
+
+// newLogger 派生附带 service 属性的服务日志记录器；未设置 Logger 时返回 nil，由 corectx 丢弃全部输出。
+func (ctx *ContextBehavior) newLogger() *slog.Logger {
+	if ctx.options.Logger == nil {
+		return nil
+	}
+	name := ctx.options.Name
+	if name == "" {
+		name = ctx.options.PersistID.String()
+	}
+	return ctx.options.Logger.With("service", name)
 }
 
 func (ctx *ContextBehavior) getOptions() *ContextOptions {
@@ -169,7 +187,7 @@ func (ctx *ContextBehavior) getInstance() Context {
 }
 
 func (ctx *ContextBehavior) emitEventRunningEvent(runningEvent RunningEvent, args ...any) {
-	ctx.options.RunningEventCB.Call(ctx.AutoRecover(), ctx.ReportError(), ctx.getInstance(), runningEvent, args...)
+	ctx.options.RunningEventCB.Call(ctx.AutoRecover(), ctx.PanicReporter(), ctx.getInstance(), runningEvent, args...)
 }
 
 func (ctx *ContextBehavior) getScoped() *atomic.Bool {
@@ -21,6 +21,8 @@ package service
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
 	"git.golaxy.org/core/ec/pt"
 	"git.golaxy.org/core/utils/generic"
@@ -35,15 +37,18 @@ type (
 
 // ContextOptions 定义创建服务上下文时使用的选项。
 type ContextOptions struct {
-	InstanceFace   iface.Face[Context] // 自定义上下文实例及其接口缓存。
-	Context        context.Context     // 父上下文；nil 时使用 context.Background。
-	AutoRecover    bool                // 回调发生 panic 时是否自动恢复。
-	ReportError    chan error          // 自动恢复后接收 panic 错误的通道。
-	Name           string              // 服务名称。
-	PersistID      uid.ID              // 服务持久化 ID；为 Nil 时自动生成。
-	EntityLib      pt.EntityLib        // 实体原型库；nil 时创建独立实体库。
-	AddInManager   AddInManager        // 服务插件管理器；nil 时创建默认管理器。
-	RunningEventCB RunningEventCB      // 服务运行事件回调。
+	InstanceFace    iface.Face[Context] // 自定义上下文实例及其接口缓存。
+	Context         context.Context     // 父上下文；nil 时使用 context.Background。
+	AutoRecover     bool                // 回调发生 panic 时是否自动恢复。
+	ReportError     chan error          // 自动恢复后接收 panic 错误的通道。
+	Name            string              // 服务名称。
+	PersistID       uid.ID              // 服务持久化 ID；为 Nil 时自动生成。
+	EntityLib       pt.EntityLib        // 实体原型库；nil 时创建独立实体库。
+	AddInManager    AddInManager        // 服务插件管理器；nil 时创建默认管理器。
+	EntityDirectory EntityDirectory     // 全局实体目录；nil 时创建进程内目录。
+	EntityLeaseTTL  time.Duration       // 全局实体登记的租约时长；不大于 0 时登记不过期。
+	Logger          *slog.Logger        // 结构化日志记录器；nil 时丢弃全部日志输出。
+	RunningEventCB  RunningEventCB      // 服务运行事件回调。
 }
 
 // With 提供 Service 上下文选项构造器。
@@ -61,6 +66,9 @@ func (_ContextOption) Default() option.Setting[ContextOptions] {
 		With.PersistID(uid.Nil).Apply(options)
 		With.EntityLib(nil).Apply(options)
 		With.AddInManager(nil).Apply(options)
+		With.EntityDirectory(nil).Apply(options)
+		With.EntityLeaseTTL(0).Apply(options)
+		With.Logger(nil).Apply(options)
 		With.RunningEventCB(nil).Apply(options)
 	}
 }
@@ -115,6 +123,28 @@ func (_ContextOption) AddInManager(mgr AddInManager) option.Setting[ContextOptio
 	}
 }
 
+// EntityDirectory 设置全局实体目录后端，多个服务共享同一目录时实体 ID 在服务间唯一。
+func (_ContextOption) EntityDirectory(dir EntityDirectory) option.Setting[ContextOptions] {
+	return func(options *ContextOptions) {
+		options.EntityDirectory = dir
+	}
+}
+
+// EntityLeaseTTL 设置全局实体登记的租约时长，服务每 1/3 租约时长自动续约。
+func (_ContextOption) EntityLeaseTTL(ttl time.Duration) option.Setting[ContextOptions] {
+	return func(options *ContextOptions) {
+		options.EntityLeaseTTL = ttl
+	}
+}
+
+// Logger 设置结构化日志记录器。服务上下文的日志附带 service 属性，值为服务名称，未命名时为服务 ID；
+// 运行时、实体、组件与插件的日志记录器均由其派生。启用 panic 自动恢复时，恢复的 panic 连同堆栈以 Error 级别记录。
+func (_ContextOption) Logger(logger *slog.Logger) option.Setting[ContextOptions] {
+	return func(options *ContextOptions) {
+		options.Logger = logger
+	}
+}
+
 // RunningEventCB 设置服务运行事件回调。
 func (_ContextOption) RunningEventCB(cb RunningEventCB) option.Setting[ContextOptions] {
 	return func(options *ContextOptions) {
@@ -27,6 +27,7 @@ Package service 表示应用的全局作用域，也是多个 runtime 的父上
   - 提供随服务关闭并汇合后台任务的 AsyncScope；
   - 管理实体原型库与组件原型库；
   - 提供全局实体索引，以及按实体 ID Submit 或 Post 到所属 Runtime；
+  - 通过可替换的 EntityDirectory 登记全局实体归属，支持租约续约与变化订阅；
   - 管理随服务同步启停的 service add-in，并派发服务运行事件。
 
 通常先用 NewContext 创建上下文，再交给 core.NewService 绑定和运行。
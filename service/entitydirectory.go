@@ -0,0 +1,84 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+//go:generate stringer -type EntityDirectoryEventType
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"git.golaxy.org/core/utils/uid"
+)
+
+// EntityRecord 描述全局实体目录中的一条登记。
+type EntityRecord struct {
+	ID        uid.ID    `json:"id"`               // ID 是实体 ID。
+	Prototype string    `json:"prototype"`        // Prototype 是实体原型名。
+	Owner     uid.ID    `json:"owner"`            // Owner 是持有该实体的服务 ID。
+	Expires   time.Time `json:"expires,omitzero"` // Expires 是租约到期时间；零值表示登记不过期。
+}
+
+// EntityDirectoryEventType 标识全局实体目录的登记变化。
+type EntityDirectoryEventType int32
+
+const (
+	EntityDirectoryEvent_Registered   EntityDirectoryEventType = iota // 实体已登记。
+	EntityDirectoryEvent_Deregistered                                 // 实体已由所有者注销。
+	EntityDirectoryEvent_Expired                                      // 实体登记因租约到期被移除。
+)
+
+// EntityDirectoryEvent 描述一次全局实体目录变化。
+type EntityDirectoryEvent struct {
+	Type   EntityDirectoryEventType // Type 是变化类型。
+	Record EntityRecord             // Record 是变化发生时的登记内容。
+}
+
+// EntityDirectory 是全局实体目录的后端，按实体 ID 记录持有实体的服务与租约。
+// 实现必须可并发使用；目录只保存登记信息，实体对象仍由持有它的服务在本地保存。
+type EntityDirectory interface {
+	// Register 以 ttl 租约登记 record，ttl 不大于 0 时登记不过期，返回实际保存的登记。
+	// ID 已由其他服务持有且租约有效时返回 *EntityConflictError；同一服务重复登记时刷新登记内容与租约。
+	Register(ctx context.Context, record EntityRecord, ttl time.Duration) (EntityRecord, error)
+	// KeepAlive 将 owner 持有的登记续约 ttl；登记不存在或已属于其他服务时返回 ErrEntityLeaseLost。
+	KeepAlive(ctx context.Context, id, owner uid.ID, ttl time.Duration) error
+	// Deregister 注销 owner 持有的登记；登记不存在或属于其他服务时不执行任何操作。
+	Deregister(ctx context.Context, id, owner uid.ID) error
+	// Lookup 按 ID 查询有效登记。
+	Lookup(ctx context.Context, id uid.ID) (EntityRecord, bool, error)
+	// Watch 订阅登记变化：先以 EntityDirectoryEvent_Registered 发送现有登记，再发送后续变化；ctx 结束后关闭通道。
+	Watch(ctx context.Context) (<-chan EntityDirectoryEvent, error)
+}
+
+// EntityConflictError 表示实体 ID 已由其他服务持有。
+type EntityConflictError struct {
+	ID     uid.ID       // ID 是发生冲突的实体 ID。
+	Holder EntityRecord // Holder 是当前持有该 ID 的登记。
+}
+
+// Error 返回冲突描述。
+func (e *EntityConflictError) Error() string {
+	return fmt.Sprintf("%s: entity %q is owned by service %q", ErrEntityConflict, e.ID, e.Holder.Owner)
+}
+
+// Unwrap 返回 ErrEntityConflict。
+func (e *EntityConflictError) Unwrap() error {
+	return ErrEntityConflict
+}
@@ -0,0 +1,165 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/generic"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// NewMemoryEntityDirectory 创建进程内的全局实体目录，也是服务未指定目录时的默认后端。
+// 多个服务共享同一个实例时，可以在进程内模拟跨服务的实体归属与租约。
+func NewMemoryEntityDirectory() EntityDirectory {
+	return &_MemoryEntityDirectory{
+		records: map[uid.ID]*_MemoryEntityRecord{},
+	}
+}
+
+type _MemoryEntityRecord struct {
+	record EntityRecord
+	timer  *time.Timer
+}
+
+type _MemoryEntityDirectory struct {
+	mutex       sync.Mutex
+	records     map[uid.ID]*_MemoryEntityRecord
+	eventStream generic.EventStream[EntityDirectoryEvent]
+}
+
+// Register 以 ttl 租约登记 record，ttl 不大于 0 时登记不过期，返回实际保存的登记。
+// ID 已由其他服务持有且租约有效时返回 *EntityConflictError；同一服务重复登记时刷新登记内容与租约。
+func (dir *_MemoryEntityDirectory) Register(ctx context.Context, record EntityRecord, ttl time.Duration) (EntityRecord, error) {
+	if record.ID.IsNil() {
+		return EntityRecord{}, fmt.Errorf("%w: %w: record id is nil", ErrEntityDirectory, exception.ErrArgs)
+	}
+	if record.Owner.IsNil() {
+		return EntityRecord{}, fmt.Errorf("%w: %w: record owner is nil", ErrEntityDirectory, exception.ErrArgs)
+	}
+
+	dir.mutex.Lock()
+	defer dir.mutex.Unlock()
+
+	if held, ok := dir.records[record.ID]; ok {
+		if held.record.Owner != record.Owner {
+			return EntityRecord{}, &EntityConflictError{ID: record.ID, Holder: held.record}
+		}
+		dir.stopLease(held)
+	}
+
+	held := &_MemoryEntityRecord{record: record}
+	dir.records[record.ID] = held
+	dir.startLease(held, ttl)
+
+	dir.eventStream.Publish(EntityDirectoryEvent{Type: EntityDirectoryEvent_Registered, Record: held.record})
+
+	return held.record, nil
+}
+
+// KeepAlive 将 owner 持有的登记续约 ttl；登记不存在或已属于其他服务时返回 ErrEntityLeaseLost。
+func (dir *_MemoryEntityDirectory) KeepAlive(ctx context.Context, id, owner uid.ID, ttl time.Duration) error {
+	dir.mutex.Lock()
+	defer dir.mutex.Unlock()
+
+	held, ok := dir.records[id]
+	if !ok || held.record.Owner != owner {
+		return fmt.Errorf("%w: entity %q", ErrEntityLeaseLost, id)
+	}
+
+	dir.stopLease(held)
+	dir.startLease(held, ttl)
+
+	return nil
+}
+
+// Deregister 注销 owner 持有的登记；登记不存在或属于其他服务时不执行任何操作。
+func (dir *_MemoryEntityDirectory) Deregister(ctx context.Context, id, owner uid.ID) error {
+	dir.mutex.Lock()
+	defer dir.mutex.Unlock()
+
+	held, ok := dir.records[id]
+	if !ok || held.record.Owner != owner {
+		return nil
+	}
+
+	dir.stopLease(held)
+	delete(dir.records, id)
+
+	dir.eventStream.Publish(EntityDirectoryEvent{Type: EntityDirectoryEvent_Deregistered, Record: held.record})
+
+	return nil
+}
+
+// Lookup 按 ID 查询有效登记。
+func (dir *_MemoryEntityDirectory) Lookup(ctx context.Context, id uid.ID) (EntityRecord, bool, error) {
+	dir.mutex.Lock()
+	defer dir.mutex.Unlock()
+
+	held, ok := dir.records[id]
+	if !ok {
+		return EntityRecord{}, false, nil
+	}
+	return held.record, true, nil
+}
+
+// Watch 订阅登记变化：先以 EntityDirectoryEvent_Registered 发送现有登记，再发送后续变化；ctx 结束后关闭通道。
+func (dir *_MemoryEntityDirectory) Watch(ctx context.Context) (<-chan EntityDirectoryEvent, error) {
+	dir.mutex.Lock()
+	defer dir.mutex.Unlock()
+
+	catchUp := make([]EntityDirectoryEvent, 0, len(dir.records))
+	for _, held := range dir.records {
+		catchUp = append(catchUp, EntityDirectoryEvent{Type: EntityDirectoryEvent_Registered, Record: held.record})
+	}
+
+	return dir.eventStream.Subscribe(ctx, catchUp...), nil
+}
+
+func (dir *_MemoryEntityDirectory) startLease(held *_MemoryEntityRecord, ttl time.Duration) {
+	if ttl <= 0 {
+		held.record.Expires = time.Time{}
+		return
+	}
+
+	held.record.Expires = time.Now().Add(ttl)
+	held.timer = time.AfterFunc(ttl, func() {
+		dir.mutex.Lock()
+		defer dir.mutex.Unlock()
+
+		if dir.records[held.record.ID] != held || time.Now().Before(held.record.Expires) {
+			return
+		}
+		delete(dir.records, held.record.ID)
+
+		dir.eventStream.Publish(EntityDirectoryEvent{Type: EntityDirectoryEvent_Expired, Record: held.record})
+	})
+}
+
+func (dir *_MemoryEntityDirectory) stopLease(held *_MemoryEntityRecord) {
+	if held.timer != nil {
+		held.timer.Stop()
+		held.timer = nil
+	}
+}
@@ -20,8 +20,11 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"git.golaxy.org/core/ec"
 	"git.golaxy.org/core/utils/exception"
@@ -29,24 +32,52 @@ import (
 	"git.golaxy.org/core/utils/uid"
 )
 
+// entityDirectoryTimeout 是未启用租约时登记请求的超时时间，也是不随服务退出而取消的注销请求的超时时间。
+const entityDirectoryTimeout = 5 * time.Second
+
 // EntityManager 提供跨运行时可并发访问的全局实体索引。
 // 只有 Scope_Global 实体会由运行时注册到该索引。
+// 本地索引保存实体对象，实体归属与租约由 EntityDirectory 登记，多个服务共享同一目录时 ID 在服务间唯一。
+// 本地索引同时按原型名与原型声明的 IndexedMeta 键值建立二级索引，二级索引与注册、注销原子地一同更新。
+// 实体在目录登记成功后才加入本地索引，登记请求的等待时间不超过租约时长，未启用租约时不超过 5 秒；
+// 注销时实体立即移出本地索引，目录注销请求在后台完成，同一 ID 再次登记前会等待此前的注销完成。
 // 注册与注销事件在调用方 goroutine 中同步派发，因此不同 Runtime 可能并发触发回调。
 type EntityManager interface {
-	// GetEntity 按 ID 查询全局实体。
+	// GetEntity 按 ID 查询本服务持有的全局实体。
 	GetEntity(id uid.ID) (ec.ConcurrentEntity, bool)
 	// GetOrAddEntity 原子地查询或注册全局实体，并报告该 ID 是否已经存在。
+	// 索引保存绑定实体当前生命周期的 ec.ConcurrentRef 引用，实体从实体池复用后旧引用不会指向新的实体。
+	// ID 已由其他服务在目录中持有时返回可用 errors.As 解析的 *EntityConflictError。
 	GetOrAddEntity(entity ec.ConcurrentEntity) (ec.ConcurrentEntity, bool, error)
 	// RemoveEntity 按 ID 注销全局实体；实体不存在时不执行任何操作。
 	RemoveEntity(id uid.ID)
+	// GetEntitiesByPrototype 按原型名查询本服务持有的全局实体，按注册顺序返回。
+	GetEntitiesByPrototype(prototype string) []ec.ConcurrentEntity
+	// GetEntitiesByMeta 按原型声明的索引元数据键值查询本服务持有的全局实体，按注册顺序返回。
+	// 索引值在注册时取自实体元数据，缺失时取自原型元数据；值按 == 比较，类型须一致。
+	GetEntitiesByMeta(key string, value any) []ec.ConcurrentEntity
+	// GetEntityByMeta 按原型声明的索引元数据键值查询最早注册的全局实体。
+	GetEntityByMeta(key string, value any) (ec.ConcurrentEntity, bool)
+	// LookupEntity 在全局实体目录中按 ID 查询登记，可查询其他服务持有的实体。
+	LookupEntity(ctx context.Context, id uid.ID) (EntityRecord, bool, error)
+	// Watch 订阅全局实体目录的登记变化，包含其他服务的登记、注销与租约到期。
+	Watch(ctx context.Context) (<-chan EntityDirectoryEvent, error)
+	// Directory 返回全局实体目录后端。
+	Directory() EntityDirectory
 }
 
 type _EntityManager struct {
-	ctx      Context
-	entities sync.Map
+	ctx           Context
+	directory     EntityDirectory
+	leaseTTL      time.Duration
+	entities      sync.Map
+	mutex         sync.RWMutex
+	index         _EntityIndex
+	keepAliveOnce sync.Once
+	deregistering sync.Map
 }
 
-// GetEntity 按 ID 查询全局实体。
+// GetEntity 按 ID 查询本服务持有的全局实体。
 func (mgr *_EntityManager) GetEntity(id uid.ID) (ec.ConcurrentEntity, bool) {
 	v, ok := mgr.entities.Load(id)
 	if !ok {
@@ -57,11 +88,15 @@ func (mgr *_EntityManager) GetEntity(id uid.ID) (ec.ConcurrentEntity, bool) {
 }
 
 // GetOrAddEntity 原子地查询或注册全局实体，并报告该 ID 是否已经存在。
+// 索引保存绑定实体当前生命周期的 ec.ConcurrentRef 引用，实体从实体池复用后旧引用不会指向新的实体。
+// ID 已由其他服务在目录中持有时返回可用 errors.As 解析的 *EntityConflictError。
 func (mgr *_EntityManager) GetOrAddEntity(entity ec.ConcurrentEntity) (ec.ConcurrentEntity, bool, error) {
 	if entity == nil {
 		return nil, false, fmt.Errorf("%w: %w: entity is nil", ErrEntityManager, exception.ErrArgs)
 	}
 
+	entity = ec.ConcurrentRef(entity)
+
 	if entity.ID().IsNil() {
 		return nil, false, fmt.Errorf("%w: entity id is nil", ErrEntityManager)
 	}
@@ -76,27 +111,246 @@ func (mgr *_EntityManager) GetOrAddEntity(entity ec.ConcurrentEntity) (ec.Concur
 	default:
 	}
 
-	actual, loaded := mgr.entities.LoadOrStore(entity.ID(), entity)
-	if !loaded {
-		mgr.ctx.emitEventRunningEvent(RunningEvent_EntityRegistered, entity)
+	if actual, ok := mgr.entities.Load(entity.ID()); ok {
+		return actual.(ec.ConcurrentEntity), true, nil
+	}
+
+	if err := mgr.register(mgr.ctx, entity); err != nil {
+		return nil, false, fmt.Errorf("%w: %w", ErrEntityManager, err)
 	}
 
-	return actual.(ec.ConcurrentEntity), loaded, nil
+	actual, loaded := mgr.store(entity)
+	if loaded {
+		return actual, true, nil
+	}
+
+	if mgr.leaseTTL > 0 {
+		mgr.keepAliveOnce.Do(mgr.startKeepAlive)
+	}
+
+	mgr.ctx.emitEventRunningEvent(RunningEvent_EntityRegistered, entity)
+
+	return entity, false, nil
 }
 
 // RemoveEntity 按 ID 注销全局实体；实体不存在时不执行任何操作。
 func (mgr *_EntityManager) RemoveEntity(id uid.ID) {
-	entity, loaded := mgr.entities.LoadAndDelete(id)
+	entity, loaded := mgr.delete(id, nil)
 	if !loaded {
 		return
 	}
+
+	mgr.deregister(id)
+
 	mgr.ctx.emitEventRunningEvent(RunningEvent_EntityDeregistered, entity)
 }
 
-func (mgr *_EntityManager) init(ctx Context) {
+// GetEntitiesByPrototype 按原型名查询本服务持有的全局实体，按注册顺序返回。
+func (mgr *_EntityManager) GetEntitiesByPrototype(prototype string) []ec.ConcurrentEntity {
+	mgr.mutex.RLock()
+	defer mgr.mutex.RUnlock()
+
+	return mgr.index.getByPrototype(prototype)
+}
+
+// GetEntitiesByMeta 按原型声明的索引元数据键值查询本服务持有的全局实体，按注册顺序返回。
+// 索引值在注册时取自实体元数据，缺失时取自原型元数据；值按 == 比较，类型须一致。
+func (mgr *_EntityManager) GetEntitiesByMeta(key string, value any) []ec.ConcurrentEntity {
+	mgr.mutex.RLock()
+	defer mgr.mutex.RUnlock()
+
+	return mgr.index.getByMeta(key, value)
+}
+
+// GetEntityByMeta 按原型声明的索引元数据键值查询最早注册的全局实体。
+func (mgr *_EntityManager) GetEntityByMeta(key string, value any) (ec.ConcurrentEntity, bool) {
+	entities := mgr.GetEntitiesByMeta(key, value)
+	if len(entities) <= 0 {
+		return nil, false
+	}
+	return entities[0], true
+}
+
+// LookupEntity 在全局实体目录中按 ID 查询登记，可查询其他服务持有的实体。
+func (mgr *_EntityManager) LookupEntity(ctx context.Context, id uid.ID) (EntityRecord, bool, error) {
+	if ctx == nil {
+		ctx = mgr.ctx
+	}
+	return mgr.directory.Lookup(ctx, id)
+}
+
+// Watch 订阅全局实体目录的登记变化，包含其他服务的登记、注销与租约到期。
+func (mgr *_EntityManager) Watch(ctx context.Context) (<-chan EntityDirectoryEvent, error) {
+	if ctx == nil {
+		ctx = mgr.ctx
+	}
+	return mgr.directory.Watch(ctx)
+}
+
+// Directory 返回全局实体目录后端。
+func (mgr *_EntityManager) Directory() EntityDirectory {
+	return mgr.directory
+}
+
+func (mgr *_EntityManager) init(ctx Context, directory EntityDirectory, leaseTTL time.Duration) {
 	if ctx == nil {
 		exception.Panicf("%w: %w: ctx is nil", ErrEntityManager, exception.ErrArgs)
 	}
 
+	if directory == nil {
+		exception.Panicf("%w: %w: directory is nil", ErrEntityManager, exception.ErrArgs)
+	}
+
 	mgr.ctx = ctx
+	mgr.directory = directory
+	mgr.leaseTTL = max(leaseTTL, 0)
+}
+
+// store 将实体加入本地索引并建立二级索引；ID 已存在时返回已有实体。
+func (mgr *_EntityManager) store(entity ec.ConcurrentEntity) (ec.ConcurrentEntity, bool) {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+
+	actual, loaded := mgr.entities.LoadOrStore(entity.ID(), entity)
+	if !loaded {
+		mgr.index.add(entity)
+	}
+	return actual.(ec.ConcurrentEntity), loaded
+}
+
+// delete 将实体移出本地索引与二级索引；expected 不为 nil 时仅在索引中的实体与其相同时移除。
+func (mgr *_EntityManager) delete(id uid.ID, expected ec.ConcurrentEntity) (ec.ConcurrentEntity, bool) {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+
+	v, ok := mgr.entities.Load(id)
+	if !ok || (expected != nil && v != expected) {
+		return nil, false
+	}
+	mgr.entities.Delete(id)
+	mgr.index.remove(id)
+
+	return v.(ec.ConcurrentEntity), true
+}
+
+// register 在目录中登记实体，等待时间不超过租约时长，未启用租约时不超过 entityDirectoryTimeout；
+// 同一 ID 的注销请求仍在进行时先等待其完成，避免迟到的注销撤销新的登记。
+func (mgr *_EntityManager) register(ctx context.Context, entity ec.ConcurrentEntity) error {
+	record := EntityRecord{
+		ID:        entity.ID(),
+		Prototype: entity.PT().Prototype(),
+		Owner:     mgr.ctx.ID(),
+	}
+
+	timeout := mgr.leaseTTL
+	if timeout <= 0 {
+		timeout = entityDirectoryTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if v, ok := mgr.deregistering.Load(record.ID); ok {
+		select {
+		case <-v.(chan struct{}):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	_, err := mgr.directory.Register(ctx, record, mgr.leaseTTL)
+	return err
+}
+
+// deregister 在后台向目录注销实体，不随服务退出而取消，等待时间不超过 entityDirectoryTimeout；
+// 服务已停止接收后台任务时在调用方 goroutine 中同步注销。
+func (mgr *_EntityManager) deregister(id uid.ID) {
+	done := make(chan struct{})
+	prev, _ := mgr.deregistering.Swap(id, done)
+
+	deregister := func() {
+		defer close(done)
+		defer mgr.deregistering.CompareAndDelete(id, done)
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(mgr.ctx), entityDirectoryTimeout)
+		defer cancel()
+
+		if prev != nil {
+			select {
+			case <-prev.(chan struct{}):
+			case <-ctx.Done():
+			}
+		}
+
+		if err := mgr.directory.Deregister(ctx, id, mgr.ctx.ID()); err != nil {
+			mgr.reportError(fmt.Errorf("%w: entity %q deregister failed, %w", ErrEntityManager, id, err))
+		}
+	}
+
+	if !mgr.ctx.WaitGroup().Join(1) {
+		deregister()
+		return
+	}
+
+	go func() {
+		defer mgr.ctx.WaitGroup().Done()
+		deregister()
+	}()
+}
+
+// startKeepAlive 启动租约续约循环，每 1/3 租约时长为本服务持有的全部实体续约。
+// 租约丢失时尝试重新登记，失败则将实体移出本地索引并触发 RunningEvent_EntityLeaseLost。
+func (mgr *_EntityManager) startKeepAlive() {
+	if !mgr.ctx.WaitGroup().Join(1) {
+		return
+	}
+
+	go func() {
+		defer mgr.ctx.WaitGroup().Done()
+
+		ticker := time.NewTicker(max(mgr.leaseTTL/3, time.Millisecond))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-mgr.ctx.Done():
+				return
+			case <-ticker.C:
+				mgr.entities.Range(func(k, v any) bool {
+					mgr.keepAlive(v.(ec.ConcurrentEntity))
+					return mgr.ctx.Err() == nil
+				})
+			}
+		}
+	}()
+}
+
+func (mgr *_EntityManager) keepAlive(entity ec.ConcurrentEntity) {
+	ctx, cancel := context.WithTimeout(mgr.ctx, mgr.leaseTTL)
+	defer cancel()
+
+	err := mgr.directory.KeepAlive(ctx, entity.ID(), mgr.ctx.ID(), mgr.leaseTTL)
+	if err == nil {
+		return
+	}
+
+	if !errors.Is(err, ErrEntityLeaseLost) {
+		mgr.reportError(fmt.Errorf("%w: entity %q keepalive failed, %w", ErrEntityManager, entity.ID(), err))
+		return
+	}
+
+	if err := mgr.register(mgr.ctx, entity); err != nil {
+		if _, ok := mgr.delete(entity.ID(), entity); ok {
+			mgr.ctx.emitEventRunningEvent(RunningEvent_EntityLeaseLost, entity, err)
+		}
+	}
+}
+
+func (mgr *_EntityManager) reportError(err error) {
+	if reportError := mgr.ctx.ReportError(); reportError != nil {
+		select {
+		case reportError <- err:
+		default:
+		}
+	}
 }
@@ -0,0 +1,155 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package service
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/utils/uid"
+)
+
+type _MetaIndexKey struct {
+	key   string
+	value any
+}
+
+type _IndexedEntity struct {
+	entity ec.ConcurrentEntity
+	seq    uint64
+	keys   []_MetaIndexKey
+}
+
+// _EntityIndex 维护全局实体按原型名与元数据键值的二级索引，由 _EntityManager 加锁访问。
+type _EntityIndex struct {
+	seq         uint64
+	entities    map[uid.ID]*_IndexedEntity
+	byPrototype map[string]map[uid.ID]*_IndexedEntity
+	byMeta      map[_MetaIndexKey]map[uid.ID]*_IndexedEntity
+}
+
+func (idx *_EntityIndex) add(entity ec.ConcurrentEntity) {
+	if idx.entities == nil {
+		idx.entities = map[uid.ID]*_IndexedEntity{}
+		idx.byPrototype = map[string]map[uid.ID]*_IndexedEntity{}
+		idx.byMeta = map[_MetaIndexKey]map[uid.ID]*_IndexedEntity{}
+	}
+
+	idx.seq++
+	indexed := &_IndexedEntity{
+		entity: entity,
+		seq:    idx.seq,
+		keys:   indexedMetaKeys(entity),
+	}
+	idx.entities[entity.ID()] = indexed
+
+	insert(idx.byPrototype, entity.PT().Prototype(), indexed)
+	for _, key := range indexed.keys {
+		insert(idx.byMeta, key, indexed)
+	}
+}
+
+func (idx *_EntityIndex) remove(id uid.ID) {
+	indexed, ok := idx.entities[id]
+	if !ok {
+		return
+	}
+	delete(idx.entities, id)
+
+	erase(idx.byPrototype, indexed.entity.PT().Prototype(), id)
+	for _, key := range indexed.keys {
+		erase(idx.byMeta, key, id)
+	}
+}
+
+func (idx *_EntityIndex) getByPrototype(prototype string) []ec.ConcurrentEntity {
+	return sorted(idx.byPrototype[prototype])
+}
+
+func (idx *_EntityIndex) getByMeta(key string, value any) []ec.ConcurrentEntity {
+	if !isComparable(value) {
+		return nil
+	}
+	return sorted(idx.byMeta[_MetaIndexKey{key: key, value: value}])
+}
+
+// indexedMetaKeys 按原型声明的索引键取值，实体元数据优先于原型元数据；不可比较的值不建立索引。
+func indexedMetaKeys(entity ec.ConcurrentEntity) []_MetaIndexKey {
+	var keys []_MetaIndexKey
+
+	for _, key := range entity.PT().IndexedMeta() {
+		value, ok := entity.Meta().Get(key)
+		if !ok {
+			value, ok = entity.PT().Meta().Get(key)
+		}
+		if !ok || !isComparable(value) {
+			continue
+		}
+		keys = append(keys, _MetaIndexKey{key: key, value: value})
+	}
+
+	return keys
+}
+
+func isComparable(value any) bool {
+	return value != nil && reflect.ValueOf(value).Comparable()
+}
+
+func insert[K comparable](index map[K]map[uid.ID]*_IndexedEntity, k K, indexed *_IndexedEntity) {
+	bucket, ok := index[k]
+	if !ok {
+		bucket = map[uid.ID]*_IndexedEntity{}
+		index[k] = bucket
+	}
+	bucket[indexed.entity.ID()] = indexed
+}
+
+func erase[K comparable](index map[K]map[uid.ID]*_IndexedEntity, k K, id uid.ID) {
+	bucket, ok := index[k]
+	if !ok {
+		return
+	}
+	delete(bucket, id)
+	if len(bucket) <= 0 {
+		delete(index, k)
+	}
+}
+
+func sorted(bucket map[uid.ID]*_IndexedEntity) []ec.ConcurrentEntity {
+	if len(bucket) <= 0 {
+		return nil
+	}
+
+	indexed := make([]*_IndexedEntity, 0, len(bucket))
+	for _, v := range bucket {
+		indexed = append(indexed, v)
+	}
+	slices.SortFunc(indexed, func(a, b *_IndexedEntity) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	entities := make([]ec.ConcurrentEntity, len(indexed))
+	for i, v := range indexed {
+		entities[i] = v.entity
+	}
+	return entities
+}
@@ -28,4 +28,8 @@ import (
 var (
 	ErrContext       = fmt.Errorf("%w: service-context", exception.ErrCore) // 服务上下文错误。
 	ErrEntityManager = fmt.Errorf("%w: entity-manager", ErrContext)         // 全局实体管理器错误。
+
+	ErrEntityDirectory = fmt.Errorf("%w: entity-directory", ErrContext)                  // 全局实体目录错误。
+	ErrEntityConflict  = fmt.Errorf("%w: entity ownership conflict", ErrEntityDirectory) // 实体 ID 已由其他服务持有。
+	ErrEntityLeaseLost = fmt.Errorf("%w: entity lease lost", ErrEntityDirectory)         // 实体登记已过期或已属于其他服务。
 )
@@ -0,0 +1,309 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package remotedir
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"git.golaxy.org/core/service"
+	"git.golaxy.org/core/utils/exception"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// Dial 连接远程目录服务端并创建客户端。
+func Dial(network, address string) (*Client, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRemoteDirectory, err)
+	}
+	return NewClient(conn), nil
+}
+
+// NewClient 在已建立的连接上创建客户端，客户端关闭时一并关闭连接。
+func NewClient(conn net.Conn) *Client {
+	if conn == nil {
+		exception.Panicf("%w: %w: conn is nil", ErrRemoteDirectory, exception.ErrArgs)
+	}
+
+	c := &Client{
+		conn:    conn,
+		encoder: json.NewEncoder(conn),
+		pending: map[uint64]chan _Response{},
+		watches: map[uint64]*_ClientWatch{},
+		closed:  make(chan struct{}),
+	}
+	go c.readLoop()
+
+	return c
+}
+
+// Client 是 service.EntityDirectory 的远程实现，可通过 service.With.EntityDirectory 交给服务使用。
+type Client struct {
+	conn      net.Conn
+	writeMu   sync.Mutex
+	encoder   *json.Encoder
+	mutex     sync.Mutex
+	seq       uint64
+	pending   map[uint64]chan _Response
+	watches   map[uint64]*_ClientWatch
+	closed    chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+type _ClientWatch struct {
+	events chan service.EntityDirectoryEvent
+	queue  []service.EntityDirectoryEvent
+	notify chan struct{}
+}
+
+var _ service.EntityDirectory = (*Client)(nil)
+
+// Register 以 ttl 租约登记 record，ttl 不大于 0 时登记不过期，返回实际保存的登记。
+func (c *Client) Register(ctx context.Context, record service.EntityRecord, ttl time.Duration) (service.EntityRecord, error) {
+	resp, err := c.call(ctx, _Request{Op: opRegister, Record: record, TTL: ttl})
+	if err != nil {
+		return service.EntityRecord{}, err
+	}
+	return resp.Record, nil
+}
+
+// KeepAlive 将 owner 持有的登记续约 ttl；登记不存在或已属于其他服务时返回 service.ErrEntityLeaseLost。
+func (c *Client) KeepAlive(ctx context.Context, id, owner uid.ID, ttl time.Duration) error {
+	_, err := c.call(ctx, _Request{Op: opKeepAlive, ID: id, Owner: owner, TTL: ttl})
+	return err
+}
+
+// Deregister 注销 owner 持有的登记。
+func (c *Client) Deregister(ctx context.Context, id, owner uid.ID) error {
+	_, err := c.call(ctx, _Request{Op: opDeregister, ID: id, Owner: owner})
+	return err
+}
+
+// Lookup 按 ID 查询有效登记。
+func (c *Client) Lookup(ctx context.Context, id uid.ID) (service.EntityRecord, bool, error) {
+	resp, err := c.call(ctx, _Request{Op: opLookup, ID: id})
+	if err != nil {
+		return service.EntityRecord{}, false, err
+	}
+	return resp.Record, resp.Found, nil
+}
+
+// Watch 订阅登记变化；ctx 结束或连接断开后关闭通道。
+func (c *Client) Watch(ctx context.Context) (<-chan service.EntityDirectoryEvent, error) {
+	watch := &_ClientWatch{
+		events: make(chan service.EntityDirectoryEvent),
+		notify: make(chan struct{}, 1),
+	}
+
+	seq, resp, err := c.send(_Request{Op: opWatch}, watch)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case r, ok := <-resp:
+		if !ok {
+			return nil, c.closeErr
+		}
+		if err := decodeError(r.Error); err != nil {
+			c.removeWatch(seq)
+			return nil, err
+		}
+	case <-ctx.Done():
+		c.unwatch(seq)
+		return nil, context.Cause(ctx)
+	}
+
+	go func() {
+		defer close(watch.events)
+		for {
+			c.mutex.Lock()
+			var event service.EntityDirectoryEvent
+			hasEvent := len(watch.queue) > 0
+			if hasEvent {
+				event = watch.queue[0]
+				watch.queue = watch.queue[1:]
+			}
+			c.mutex.Unlock()
+
+			if !hasEvent {
+				select {
+				case <-watch.notify:
+					continue
+				case <-ctx.Done():
+					c.unwatch(seq)
+					return
+				case <-c.closed:
+					return
+				}
+			}
+
+			select {
+			case watch.events <- event:
+			case <-ctx.Done():
+				c.unwatch(seq)
+				return
+			case <-c.closed:
+				return
+			}
+		}
+	}()
+
+	return watch.events, nil
+}
+
+// Close 关闭连接，未完成的请求返回 ErrClosed，全部 Watch 通道随之关闭。
+func (c *Client) Close() error {
+	return c.shutdown(ErrClosed)
+}
+
+func (c *Client) call(ctx context.Context, req _Request) (_Response, error) {
+	seq, resp, err := c.send(req, nil)
+	if err != nil {
+		return _Response{}, err
+	}
+
+	select {
+	case r, ok := <-resp:
+		if !ok {
+			return _Response{}, c.closeErr
+		}
+		return r, decodeError(r.Error)
+	case <-ctx.Done():
+		c.mutex.Lock()
+		delete(c.pending, seq)
+		c.mutex.Unlock()
+		return _Response{}, fmt.Errorf("%w: %w", ErrRemoteDirectory, context.Cause(ctx))
+	}
+}
+
+func (c *Client) send(req _Request, watch *_ClientWatch) (uint64, <-chan _Response, error) {
+	resp := make(chan _Response, 1)
+
+	c.mutex.Lock()
+	select {
+	case <-c.closed:
+		c.mutex.Unlock()
+		return 0, nil, c.closeErr
+	default:
+	}
+	c.seq++
+	req.Seq = c.seq
+	c.pending[req.Seq] = resp
+	if watch != nil {
+		c.watches[req.Seq] = watch
+	}
+	c.mutex.Unlock()
+
+	if err := c.write(req); err != nil {
+		return 0, nil, err
+	}
+
+	return req.Seq, resp, nil
+}
+
+func (c *Client) write(req _Request) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if err := c.encoder.Encode(req); err != nil {
+		err = fmt.Errorf("%w: %w", ErrClosed, err)
+		c.shutdown(err)
+		return err
+	}
+	return nil
+}
+
+func (c *Client) unwatch(seq uint64) {
+	if c.removeWatch(seq) {
+		c.write(_Request{Op: opUnwatch, Watch: seq})
+	}
+}
+
+func (c *Client) removeWatch(seq uint64) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	_, ok := c.watches[seq]
+	delete(c.watches, seq)
+	delete(c.pending, seq)
+	return ok
+}
+
+func (c *Client) readLoop() {
+	scanner := bufio.NewScanner(c.conn)
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		var resp _Response
+		if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			c.shutdown(fmt.Errorf("%w: %w", ErrRemoteDirectory, err))
+			return
+		}
+
+		c.mutex.Lock()
+		if resp.Event != nil {
+			if watch, ok := c.watches[resp.Seq]; ok {
+				watch.queue = append(watch.queue, *resp.Event)
+				select {
+				case watch.notify <- struct{}{}:
+				default:
+				}
+			}
+		} else if pending, ok := c.pending[resp.Seq]; ok {
+			delete(c.pending, resp.Seq)
+			pending <- resp
+		}
+		c.mutex.Unlock()
+	}
+
+	err := scanner.Err()
+	if err == nil {
+		err = ErrClosed
+	} else {
+		err = fmt.Errorf("%w: %w", ErrClosed, err)
+	}
+	c.shutdown(err)
+}
+
+func (c *Client) shutdown(err error) error {
+	var closeErr error
+	c.closeOnce.Do(func() {
+		c.mutex.Lock()
+		c.closeErr = err
+		close(c.closed)
+		for seq, pending := range c.pending {
+			close(pending)
+			delete(c.pending, seq)
+		}
+		clear(c.watches)
+		c.mutex.Unlock()
+
+		closeErr = c.conn.Close()
+	})
+	return closeErr
+}
@@ -0,0 +1,34 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+// Package remotedir 提供通过网络连接访问全局实体目录的参考实现。
+/*
+Package remotedir 演示如何将 service.EntityDirectory 放到独立进程中，供多个服务共享。
+
+  - Server：将任意 service.EntityDirectory 后端暴露在 net.Listener 或 net.Conn 上；
+  - Client：实现 service.EntityDirectory，将请求转发给 Server。
+
+协议为逐行 JSON：客户端发送带序号的请求，服务端按序号返回应答；Watch 订阅的变化
+以订阅请求的序号主动推送，直到客户端取消订阅或连接断开。冲突与租约丢失会以错误码
+传输，客户端还原为 *service.EntityConflictError 与 service.ErrEntityLeaseLost，
+因此 GetOrAddEntity 的调用方无需区分目录后端。
+
+该实现不包含鉴权、重连与持久化，仅作为对接真实目录服务时的参考。
+*/
+package remotedir
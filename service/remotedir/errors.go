@@ -0,0 +1,31 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package remotedir
+
+import (
+	"fmt"
+
+	"git.golaxy.org/core/service"
+)
+
+var (
+	ErrRemoteDirectory = fmt.Errorf("%w: remote", service.ErrEntityDirectory)    // ErrRemoteDirectory 是远程目录错误的共同根错误。
+	ErrClosed          = fmt.Errorf("%w: connection closed", ErrRemoteDirectory) // ErrClosed 标识连接已关闭。
+)
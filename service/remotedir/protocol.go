@@ -0,0 +1,105 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package remotedir
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"git.golaxy.org/core/service"
+	"git.golaxy.org/core/utils/uid"
+)
+
+// 请求操作。
+const (
+	opRegister   = "register"
+	opKeepAlive  = "keepalive"
+	opDeregister = "deregister"
+	opLookup     = "lookup"
+	opWatch      = "watch"
+	opUnwatch    = "unwatch"
+)
+
+// 错误码。
+const (
+	codeConflict  = "conflict"
+	codeLeaseLost = "lease_lost"
+	codeOther     = "other"
+)
+
+// _Request 是客户端发送的一行请求。
+type _Request struct {
+	Seq    uint64               `json:"seq"`
+	Op     string               `json:"op"`
+	Record service.EntityRecord `json:"record,omitzero"`
+	ID     uid.ID               `json:"id,omitempty"`
+	Owner  uid.ID               `json:"owner,omitempty"`
+	TTL    time.Duration        `json:"ttl,omitempty"`
+	Watch  uint64               `json:"watch,omitempty"` // Watch 是 unwatch 请求要取消的订阅序号。
+}
+
+// _Response 是服务端发送的一行应答或 Watch 推送。
+type _Response struct {
+	Seq    uint64                        `json:"seq"`
+	Record service.EntityRecord          `json:"record,omitzero"`
+	Found  bool                          `json:"found,omitempty"`
+	Error  *_Error                       `json:"error,omitempty"`
+	Event  *service.EntityDirectoryEvent `json:"event,omitempty"`
+}
+
+// _Error 是应答中携带的错误。
+type _Error struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message"`
+	Holder  service.EntityRecord `json:"holder,omitzero"`
+}
+
+func encodeError(err error) *_Error {
+	if err == nil {
+		return nil
+	}
+
+	var conflict *service.EntityConflictError
+	if errors.As(err, &conflict) {
+		return &_Error{Code: codeConflict, Message: err.Error(), Holder: conflict.Holder}
+	}
+
+	if errors.Is(err, service.ErrEntityLeaseLost) {
+		return &_Error{Code: codeLeaseLost, Message: err.Error()}
+	}
+
+	return &_Error{Code: codeOther, Message: err.Error()}
+}
+
+func decodeError(e *_Error) error {
+	if e == nil {
+		return nil
+	}
+
+	switch e.Code {
+	case codeConflict:
+		return &service.EntityConflictError{ID: e.Holder.ID, Holder: e.Holder}
+	case codeLeaseLost:
+		return fmt.Errorf("%w: %s", service.ErrEntityLeaseLost, e.Message)
+	default:
+		return fmt.Errorf("%w: %s", ErrRemoteDirectory, e.Message)
+	}
+}
@@ -0,0 +1,179 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package remotedir
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+
+	"git.golaxy.org/core/service"
+	"git.golaxy.org/core/utils/exception"
+)
+
+// NewServer 创建将 backend 暴露给远程客户端的服务端。
+func NewServer(backend service.EntityDirectory) *Server {
+	if backend == nil {
+		exception.Panicf("%w: %w: backend is nil", ErrRemoteDirectory, exception.ErrArgs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Server{
+		backend:   backend,
+		ctx:       ctx,
+		cancel:    cancel,
+		listeners: map[net.Listener]struct{}{},
+	}
+}
+
+// Server 在网络连接上提供全局实体目录服务。
+type Server struct {
+	backend   service.EntityDirectory
+	ctx       context.Context
+	cancel    context.CancelFunc
+	mutex     sync.Mutex
+	listeners map[net.Listener]struct{}
+	wg        sync.WaitGroup
+}
+
+// Serve 在 listener 上接受连接并逐个服务，直到 listener 关闭或服务端关闭。
+func (s *Server) Serve(listener net.Listener) error {
+	s.mutex.Lock()
+	if s.ctx.Err() != nil {
+		s.mutex.Unlock()
+		return ErrClosed
+	}
+	s.listeners[listener] = struct{}{}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		delete(s.listeners, listener)
+		s.mutex.Unlock()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.ServeConn(conn)
+		}()
+	}
+}
+
+// ServeConn 服务单个连接，连接断开或服务端关闭后返回，并取消该连接上的全部 Watch 订阅。
+func (s *Server) ServeConn(conn net.Conn) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	defer conn.Close()
+
+	var writeMu sync.Mutex
+	encoder := json.NewEncoder(conn)
+	write := func(resp _Response) {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		encoder.Encode(resp)
+	}
+
+	watches := map[uint64]context.CancelFunc{}
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		var req _Request
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			return
+		}
+
+		switch req.Op {
+		case opRegister:
+			record, err := s.backend.Register(ctx, req.Record, req.TTL)
+			write(_Response{Seq: req.Seq, Record: record, Error: encodeError(err)})
+
+		case opKeepAlive:
+			err := s.backend.KeepAlive(ctx, req.ID, req.Owner, req.TTL)
+			write(_Response{Seq: req.Seq, Error: encodeError(err)})
+
+		case opDeregister:
+			err := s.backend.Deregister(ctx, req.ID, req.Owner)
+			write(_Response{Seq: req.Seq, Error: encodeError(err)})
+
+		case opLookup:
+			record, found, err := s.backend.Lookup(ctx, req.ID)
+			write(_Response{Seq: req.Seq, Record: record, Found: found, Error: encodeError(err)})
+
+		case opWatch:
+			watchCtx, watchCancel := context.WithCancel(ctx)
+			events, err := s.backend.Watch(watchCtx)
+			if err != nil {
+				watchCancel()
+				write(_Response{Seq: req.Seq, Error: encodeError(err)})
+				continue
+			}
+			watches[req.Seq] = watchCancel
+			write(_Response{Seq: req.Seq})
+
+			go func(seq uint64) {
+				for event := range events {
+					write(_Response{Seq: seq, Event: &event})
+				}
+			}(req.Seq)
+
+		case opUnwatch:
+			if watchCancel, ok := watches[req.Watch]; ok {
+				watchCancel()
+				delete(watches, req.Watch)
+			}
+
+		default:
+			write(_Response{Seq: req.Seq, Error: &_Error{Code: codeOther, Message: "unknown op " + req.Op}})
+		}
+	}
+}
+
+// Close 关闭服务端、全部 listener 与连接，并等待连接处理结束。
+func (s *Server) Close() error {
+	s.mutex.Lock()
+	s.cancel()
+	var err error
+	for listener := range s.listeners {
+		err = errors.Join(err, listener.Close())
+	}
+	s.mutex.Unlock()
+
+	s.wg.Wait()
+	return err
+}
@@ -34,4 +34,5 @@ const (
 	RunningEvent_ComponentPTDeclared                     // 组件原型已声明。
 	RunningEvent_EntityRegistered                        // 全局实体已注册。
 	RunningEvent_EntityDeregistered                      // 全局实体已注销。
+	RunningEvent_EntityLeaseLost                         // 全局实体租约丢失且重新登记失败，实体已移出全局索引；参数为实体与错误。
 )
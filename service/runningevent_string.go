@@ -18,11 +18,12 @@ func _() {
 	_ = x[RunningEvent_ComponentPTDeclared-7]
 	_ = x[RunningEvent_EntityRegistered-8]
 	_ = x[RunningEvent_EntityDeregistered-9]
+	_ = x[RunningEvent_EntityLeaseLost-10]
 }
 
-const _RunningEvent_name = "RunningEvent_BirthRunningEvent_StartingRunningEvent_StartedRunningEvent_HeartbeatRunningEvent_TerminatingRunningEvent_TerminatedRunningEvent_EntityPTDeclaredRunningEvent_ComponentPTDeclaredRunningEvent_EntityRegisteredRunningEvent_EntityDeregistered"
+const _RunningEvent_name = "RunningEvent_BirthRunningEvent_StartingRunningEvent_StartedRunningEvent_HeartbeatRunningEvent_TerminatingRunningEvent_TerminatedRunningEvent_EntityPTDeclaredRunningEvent_ComponentPTDeclaredRunningEvent_EntityRegisteredRunningEvent_EntityDeregisteredRunningEvent_EntityLeaseLost"
 
-var _RunningEvent_index = [...]uint8{0, 18, 39, 59, 81, 105, 128, 157, 189, 218, 249}
+var _RunningEvent_index = [...]uint16{0, 18, 39, 59, 81, 105, 128, 157, 189, 218, 249, 277}
 
 func (i RunningEvent) String() string {
 	idx := int(i) - 0
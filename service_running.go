@@ -155,9 +155,9 @@ func (svc *ServiceBehavior) shutAddIn() {
 
 func (svc *ServiceBehavior) activateAddIn(status service.AddInStatus) {
 	if cb, ok := status.InstanceFace().Iface.(LifecycleAddInInit); ok {
-		generic.CastAction2(cb.Init).Call(svc.ctx.AutoRecover(), svc.ctx.ReportError(), svc.ctx, nil)
+		generic.CastAction2(cb.Init).Call(svc.ctx.AutoRecover(), svc.ctx.PanicReporter(), svc.ctx, nil)
 	} else if cb, ok := status.InstanceFace().Iface.(LifecycleServiceAddInInit); ok {
-		generic.CastAction1(cb.Init).Call(svc.ctx.AutoRecover(), svc.ctx.ReportError(), svc.ctx)
+		generic.CastAction1(cb.Init).Call(svc.ctx.AutoRecover(), svc.ctx.PanicReporter(), svc.ctx)
 	}
 
 	service.UnsafeAddInStatus(status).Started()
@@ -170,9 +170,9 @@ func (svc *ServiceBehavior) deactivateAddIn(status service.AddInStatus) {
 	}
 
 	if cb, ok := addIn.(LifecycleAddInShut); ok {
-		generic.CastAction2(cb.Shut).Call(svc.ctx.AutoRecover(), svc.ctx.ReportError(), svc.ctx, nil)
+		generic.CastAction2(cb.Shut).Call(svc.ctx.AutoRecover(), svc.ctx.PanicReporter(), svc.ctx, nil)
 	} else if cb, ok := addIn.(LifecycleServiceAddInShut); ok {
-		generic.CastAction1(cb.Shut).Call(svc.ctx.AutoRecover(), svc.ctx.ReportError(), svc.ctx)
+		generic.CastAction1(cb.Shut).Call(svc.ctx.AutoRecover(), svc.ctx.PanicReporter(), svc.ctx)
 	}
 
 	service.UnsafeAddInStatus(status).Stopped()
@@ -0,0 +1,128 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"git.golaxy.org/core/ec"
+	"git.golaxy.org/core/runtime"
+	"git.golaxy.org/core/utils/corectx"
+	"git.golaxy.org/core/utils/exception"
+)
+
+// Timer 是 Runtime 定时器的句柄，只能在所属 Runtime goroutine 中使用。零值表示已停止的定时器。
+type Timer struct {
+	timer *_Timer
+}
+
+// Stop 停止定时器；定时器尚未停止时返回 true。周期定时器停止后不再触发。
+func (t Timer) Stop() bool {
+	if t.timer == nil {
+		return false
+	}
+	return t.timer.service.stop(t.timer)
+}
+
+// Stopped 报告定时器是否已停止；一次性定时器触发后同样视为已停止。
+func (t Timer) Stopped() bool {
+	return t.timer == nil || t.timer.stopped
+}
+
+// Paused 报告定时器是否因所属组件被禁用而暂停。
+func (t Timer) Paused() bool {
+	return t.timer != nil && !t.timer.stopped && t.timer.paused
+}
+
+// AfterFunc 在 dur 后于 owner 所属 Runtime goroutine 中执行一次 fn。
+//
+// owner 为组件时，定时器随组件 Shut 或移除自动停止，组件禁用期间暂停计时、重新启用后继续；
+// owner 为实体时，定时器随实体 Shut 自动停止；其他 provider 的定时器随 Runtime 终止停止。
+// 必须在 owner 所属 Runtime goroutine 中调用；owner 已进入关闭阶段时返回已停止的定时器。
+// fn 的 panic 按 Runtime 的 AutoRecover 配置处理。
+func AfterFunc(owner corectx.CurrentContextProvider, dur time.Duration, fn func()) Timer {
+	return newTimer(owner, fn, 0, false, int64(max(dur, 0)))
+}
+
+// EveryFunc 每隔 interval 于 owner 所属 Runtime goroutine 中执行 fn，直到定时器停止。
+// 执行落后超过一个周期时跳过错过的触发，不会连续补发。所属关系与自动停止规则同 AfterFunc。
+func EveryFunc(owner corectx.CurrentContextProvider, interval time.Duration, fn func()) Timer {
+	if interval <= 0 {
+		exception.Panicf("%w: %w: interval must be positive", ErrCore, ErrArgs)
+	}
+	return newTimer(owner, fn, interval, false, int64(interval))
+}
+
+// AfterFrames 在 frames 帧后于 owner 所属 Runtime goroutine 的帧更新前执行一次 fn。
+// 所属关系与自动停止规则同 AfterFunc；Runtime 未启用帧循环时会 panic。
+func AfterFrames(owner corectx.CurrentContextProvider, frames int64, fn func()) Timer {
+	return newTimer(owner, fn, 0, true, max(frames, 0))
+}
+
+func newTimer(owner corectx.CurrentContextProvider, fn func(), interval time.Duration, byFrames bool, after int64) Timer {
+	if owner == nil {
+		exception.Panicf("%w: %w: owner is nil", ErrCore, ErrArgs)
+	}
+	if fn == nil {
+		exception.Panicf("%w: %w: fn is nil", ErrCore, ErrArgs)
+	}
+
+	timers := getTimerService(runtime.Current(owner))
+	if byFrames && timers.rt.frame == nil {
+		exception.Panicf("%w: frame loop is disabled, cannot schedule frame timer", ErrRuntime)
+	}
+
+	var key any
+	paused := false
+	switch owner := owner.(type) {
+	case ec.Component:
+		key = owner
+		paused = !owner.Enabled()
+	case ec.Entity:
+		key = owner
+	}
+
+	if !timerOwnerAlive(key) {
+		return Timer{}
+	}
+	return Timer{timer: timers.add(key, fn, interval, byFrames, after, paused)}
+}
+
+func getTimerService(ctx runtime.Context) *_TimerService {
+	rt, ok := runtime.UnsafeContext(ctx).Caller().(Runtime)
+	if !ok {
+		exception.Panicf("%w: runtime context is not bound to a runtime", ErrRuntime)
+	}
+	return rt.getTimerService()
+}
+
+// lookupTimerService 在 ctx 为已绑定运行时的 runtime.Context 时返回其定时器服务，否则返回 nil。
+func lookupTimerService(ctx context.Context) (runtime.Context, *_TimerService) {
+	rtCtx, ok := ctx.(runtime.Context)
+	if !ok {
+		return nil, nil
+	}
+	rt, ok := runtime.UnsafeContext(rtCtx).Caller().(Runtime)
+	if !ok {
+		return nil, nil
+	}
+	return rtCtx, rt.getTimerService()
+}
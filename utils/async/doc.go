@@ -22,11 +22,16 @@
 Package async 将不同异步语义拆分为独立类型：
 
   - Promise/Future：非泛型、一次性、可重放的 Result；
+  - TypedPromise/TypedFuture：共享 Future 状态的类型化视图，以及 TypedAll、TypedRace 和 TypedMap；
   - Completer/Signal：不携带 Result 的生命周期完成通知；
   - Emitter/Stream：连续 Result 的单消费流；
-  - Scope/Spawn：绑定宿主生命周期的后台任务取消、汇合与统计；
+  - Scope/Spawn：绑定宿主生命周期的后台任务取消、汇合、并发上限与统计；
+  - Semaphore/SpawnWeighted：可随 Scope.Context() 取消等待的 FIFO 加权信号量；
+  - Retry/RetryPolicy：在 Scope 中按指数退避与抖动重试失败任务；
   - Race、FirstSuccess、All、AllSettled、Zip2、Map、FlatMap 和 Timeout：
-    基于完成订阅的 Future 组合器。
+    基于完成订阅的 Future 组合器；
+  - MapStream、FilterStream、Merge、Batch、Throttle、Debounce、Take 和 Collect：
+    基于转发 goroutine 的 Stream 组合器。
 
 Future 内部保存完成结果，并通过 OnComplete 在完成者 goroutine 中直接通知订阅者；
 多个 Wait、TryGet 或 OnComplete 消费者读取的是同一个可重放结果，不会竞争消费，
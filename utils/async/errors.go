@@ -26,9 +26,12 @@ import (
 )
 
 var (
-	ErrAsync             = fmt.Errorf("%w: async", exception.ErrCore)       // ErrAsync 是异步模块错误的共同根错误。
-	ErrScopeClosed       = fmt.Errorf("%w: scope closed", ErrAsync)         // ErrScopeClosed 表示异步作用域不可用或拒绝新任务。
-	ErrNoCandidates      = fmt.Errorf("%w: no future candidates", ErrAsync) // ErrNoCandidates 表示组合器没有可用的候选 Future。
-	ErrNoFutureSucceeded = fmt.Errorf("%w: no future succeeded", ErrAsync)  // ErrNoFutureSucceeded 表示所有候选 Future 均失败。
-	ErrFutureTimeout     = fmt.Errorf("%w: future timeout", ErrAsync)       // ErrFutureTimeout 表示 Future 等待超时。
+	ErrAsync              = fmt.Errorf("%w: async", exception.ErrCore)                   // ErrAsync 是异步模块错误的共同根错误。
+	ErrScopeClosed        = fmt.Errorf("%w: scope closed", ErrAsync)                     // ErrScopeClosed 表示异步作用域不可用或拒绝新任务。
+	ErrScopeLimitExceeded = fmt.Errorf("%w: scope concurrency limit exceeded", ErrAsync) // ErrScopeLimitExceeded 表示 Scope 达到并发上限并按拒绝策略拒绝新任务。
+	ErrNoCandidates       = fmt.Errorf("%w: no future candidates", ErrAsync)             // ErrNoCandidates 表示组合器没有可用的候选 Future。
+	ErrNoFutureSucceeded  = fmt.Errorf("%w: no future succeeded", ErrAsync)              // ErrNoFutureSucceeded 表示所有候选 Future 均失败。
+	ErrFutureTimeout      = fmt.Errorf("%w: future timeout", ErrAsync)                   // ErrFutureTimeout 表示 Future 等待超时。
+	ErrResultTypeMismatch = fmt.Errorf("%w: result type mismatch", ErrAsync)             // ErrResultTypeMismatch 表示结果值无法断言为类型化 Future 的值类型。
+	ErrRetryExhausted     = fmt.Errorf("%w: retry attempts exhausted", ErrAsync)         // ErrRetryExhausted 表示 Retry 用尽最大尝试次数仍未成功。
 )
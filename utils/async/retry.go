@@ -0,0 +1,137 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"git.golaxy.org/core/utils/exception"
+)
+
+// maxRetryBackoff 限制退避计算结果，避免浮点数溢出 time.Duration。
+const maxRetryBackoff = float64(math.MaxInt64 >> 1)
+
+// RetryPolicy 描述 Retry 的重试策略。零值表示不限次数、无退避地重试全部可重试错误。
+type RetryPolicy struct {
+	MaxAttempts    int              // 最大尝试次数（含首次），不大于 0 表示不限次数，直到成功或 Scope 关闭。
+	InitialBackoff time.Duration    // 首次重试前的退避时长。
+	MaxBackoff     time.Duration    // 退避时长上限，不大于 0 表示不设上限。
+	Multiplier     float64          // 每次重试后退避时长的增长倍数，小于 1 时按 1 处理，即固定退避。
+	Jitter         float64          // 随机抖动比例，取值 [0, 1]；实际退避在 [(1-Jitter)*d, d] 内均匀分布。
+	Retryable      func(error) bool // 判断错误是否可重试，nil 表示除 panic 外的错误均可重试。
+}
+
+// Backoff 返回第 attempt 次尝试失败后（attempt 从 1 开始）、下一次尝试前的退避时长。
+func (policy RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt <= 0 || policy.InitialBackoff <= 0 {
+		return 0
+	}
+
+	multiplier := max(policy.Multiplier, 1)
+	backoff := float64(policy.InitialBackoff)
+	for range attempt - 1 {
+		backoff *= multiplier
+		if backoff >= maxRetryBackoff || (policy.MaxBackoff > 0 && backoff >= float64(policy.MaxBackoff)) {
+			break
+		}
+	}
+	backoff = min(backoff, maxRetryBackoff)
+	if policy.MaxBackoff > 0 {
+		backoff = min(backoff, float64(policy.MaxBackoff))
+	}
+
+	if jitter := min(max(policy.Jitter, 0), 1); jitter > 0 {
+		backoff -= backoff * jitter * rand.Float64()
+	}
+	return time.Duration(backoff)
+}
+
+func (policy RetryPolicy) retryable(err error) bool {
+	if policy.Retryable != nil {
+		return policy.Retryable(err)
+	}
+	return !errors.Is(err, exception.ErrPanicked)
+}
+
+// Retry 在 Scope 中启动后台任务，失败时按 policy 退避后重试，并返回最终结果 Future。
+//
+// 整个重试过程在 Scope 中登记为一个任务，每次尝试计入 ScopeStats.Attempts，每次重试计入
+// ScopeStats.Retried。Scope 设置了并发上限时，任务在退避期间让出并发名额，退避结束后重新排队取得名额，
+// 重新取得名额不受 ScopeLimitPolicy_Reject 影响。task 的 panic 会转换为带堆栈的 Result.Error；不可重试的错误直接返回，
+// 用尽尝试次数时以包装 ErrRetryExhausted 与最后一次错误的错误完成。Scope 关闭时停止等待退避
+// 并以 Scope 的取消原因完成，task 应观察传入的 Context 以及时退出当前尝试。
+func Retry(scope *Scope, policy RetryPolicy, task func(context.Context) Result) Future {
+	if task == nil {
+		exception.Panicf("%w: %w: async retry task is nil", ErrAsync, exception.ErrArgs)
+	}
+	promise, future := NewPromise()
+	err := scope.start(scopeTask{
+		run:   func(context.Context) { promise.Resolve(retryLoop(scope, policy, task)) },
+		abort: func(err error) { promise.Resolve(NewResult(nil, err)) },
+	})
+	if err != nil {
+		return Rejected(err)
+	}
+	return future
+}
+
+func retryLoop(scope *Scope, policy RetryPolicy, task func(context.Context) Result) Result {
+	ctx := scope.Context()
+
+	for attempt := 1; ; attempt++ {
+		scope.recordRetry(func(stats *ScopeStats) { stats.Attempts++ })
+
+		ret := safeTaskCall(task, ctx)
+		if ret.OK() {
+			return ret
+		}
+		if ctx.Err() != nil {
+			return ret
+		}
+		if !policy.retryable(ret.Error) {
+			return ret
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			scope.recordRetry(func(stats *ScopeStats) { stats.Exhausted++ })
+			return NewResult(nil, fmt.Errorf("%w: %d attempts: %w", ErrRetryExhausted, attempt, ret.Error))
+		}
+
+		if backoff := policy.Backoff(attempt); backoff > 0 {
+			scope.suspend()
+			timer := time.NewTimer(backoff)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+			}
+			scope.resume()
+			if ctx.Err() != nil {
+				return NewResult(nil, fmt.Errorf("%w: retry canceled after %d attempts: %w", context.Cause(ctx), attempt, ret.Error))
+			}
+		}
+
+		scope.recordRetry(func(stats *ScopeStats) { stats.Retried++ })
+	}
+}
@@ -30,10 +30,16 @@ import (
 // ScopeStats 是异步作用域的瞬时统计快照。
 type ScopeStats struct {
 	Spawned   int64 // 成功注册过的任务总数。
-	Active    int64 // 当前仍未退出的任务数。
+	Active    int64 // 当前仍未退出的任务数，包括排队中的任务。
+	Running   int64 // 当前正在执行的任务数。
+	Queued    int64 // 当前等待并发名额的任务数，即队列深度。
 	Completed int64 // 正常返回的任务总数。
-	Canceled  int64 // 在 Context 已取消状态下退出的任务总数。
-	Rejected  int64 // Scope 关闭后拒绝的任务总数。
+	Canceled  int64 // 在 Context 已取消状态下退出，或排队期间因 Scope 关闭而放弃的任务总数。
+	Rejected  int64 // Scope 关闭或超出并发上限后拒绝的任务总数。
+	Limit     int   // 并发上限，0 表示不限制。
+	Attempts  int64 // Retry 发起的尝试总数。
+	Retried   int64 // Retry 因可重试错误而再次尝试的次数。
+	Exhausted int64 // Retry 用尽最大尝试次数后失败的任务总数。
 	Closed    bool  // Scope 是否已经关闭。
 }
 
@@ -47,6 +53,10 @@ type Scope struct {
 	mu        sync.Mutex
 	closed    bool
 	active    int64
+	running   int64
+	limit     int
+	policy    ScopeLimitPolicy
+	queue     []scopeTask
 	stats     ScopeStats
 	completer Completer
 	done      Signal
@@ -136,6 +146,17 @@ func (scope *Scope) Close(cause ...error) bool {
 	}
 	scope.closed = true
 	scope.stats.Closed = true
+	queue := scope.queue
+	scope.queue = nil
+	for _, task := range queue {
+		if task.resume != nil {
+			// 挂起的任务仍然登记在 Scope 中，唤醒后由其自身结束
+			scope.running++
+			continue
+		}
+		scope.active--
+		scope.stats.Canceled++
+	}
 	complete := scope.active == 0
 	stopWatch := scope.stopWatch
 	scope.stopWatch = nil
@@ -145,6 +166,13 @@ func (scope *Scope) Close(cause ...error) bool {
 		stopWatch()
 	}
 	scope.cancel(pie.First(cause))
+	for _, task := range queue {
+		if task.resume != nil {
+			close(task.resume)
+			continue
+		}
+		task.abort(scope.Err())
+	}
 	if complete {
 		scope.completer.Complete()
 	}
@@ -160,54 +188,166 @@ func (scope *Scope) Stats() ScopeStats {
 	defer scope.mu.Unlock()
 	stats := scope.stats
 	stats.Active = scope.active
+	stats.Running = scope.running
+	stats.Queued = int64(len(scope.queue))
+	stats.Limit = scope.limit
 	return stats
 }
 
-func (scope *Scope) begin() bool {
+// SetLimit 设置 Scope 同时执行的任务上限，limit 不大于 0 表示不限制；policy 决定达到上限后新任务排队还是被拒绝。
+//
+// 调低上限不会影响已在执行的任务；调高上限或解除限制会立即按 FIFO 启动排队中的任务。
+// Scope 关闭时，排队中尚未启动的任务以 Scope 的取消原因完成，并计入 Canceled。
+func (scope *Scope) SetLimit(limit int, policy ScopeLimitPolicy) {
 	if scope == nil {
-		return false
+		return
+	}
+	scope.mu.Lock()
+	scope.limit = max(limit, 0)
+	scope.policy = policy
+	ready := scope.dequeueLocked()
+	scope.mu.Unlock()
+
+	scope.launch(ready)
+}
+
+type scopeTask struct {
+	run    func(ctx context.Context)
+	abort  func(err error)
+	resume chan struct{} // 不为 nil 时表示等待重新取得并发名额的挂起任务，取得名额或 Scope 关闭时关闭。
+}
+
+// start 登记任务并在并发名额允许时启动；达到上限时按策略排队或拒绝。
+func (scope *Scope) start(task scopeTask) error {
+	if scope == nil {
+		return ErrScopeClosed
 	}
 	scope.mu.Lock()
-	defer scope.mu.Unlock()
 	if scope.closed || scope.ctx.Err() != nil {
 		scope.stats.Rejected++
-		return false
+		scope.mu.Unlock()
+		return ErrScopeClosed
+	}
+	if scope.limit > 0 && scope.running >= int64(scope.limit) {
+		if scope.policy == ScopeLimitPolicy_Reject {
+			scope.stats.Rejected++
+			scope.mu.Unlock()
+			return ErrScopeLimitExceeded
+		}
+		scope.active++
+		scope.stats.Spawned++
+		scope.queue = append(scope.queue, task)
+		scope.mu.Unlock()
+		return nil
 	}
 	scope.active++
+	scope.running++
 	scope.stats.Spawned++
-	return true
+	scope.mu.Unlock()
+
+	go scope.run(task)
+	return nil
+}
+
+func (scope *Scope) run(task scopeTask) {
+	defer scope.end()
+	task.run(scope.ctx)
 }
 
 func (scope *Scope) end() {
 	scope.mu.Lock()
 	scope.active--
+	scope.running--
 	if scope.ctx.Err() != nil {
 		scope.stats.Canceled++
 	} else {
 		scope.stats.Completed++
 	}
+	ready := scope.dequeueLocked()
 	complete := scope.closed && scope.active == 0
 	scope.mu.Unlock()
+
+	scope.launch(ready)
 	if complete {
 		scope.completer.Complete()
 	}
 }
 
+// suspend 让出当前任务占用的并发名额并启动可以执行的排队任务；任务继续执行前须调用 resume。
+func (scope *Scope) suspend() {
+	scope.mu.Lock()
+	scope.running--
+	ready := scope.dequeueLocked()
+	scope.mu.Unlock()
+
+	scope.launch(ready)
+}
+
+// resume 为挂起的任务重新取得并发名额；达到上限时排在已有排队任务之后等待，不受拒绝策略影响。
+// Scope 关闭时立即返回，任务应检查 Context 后结束。
+func (scope *Scope) resume() {
+	scope.mu.Lock()
+	if scope.closed || scope.limit <= 0 || scope.running < int64(scope.limit) {
+		scope.running++
+		scope.mu.Unlock()
+		return
+	}
+	resume := make(chan struct{})
+	scope.queue = append(scope.queue, scopeTask{resume: resume})
+	scope.mu.Unlock()
+
+	<-resume
+}
+
+// launch 启动取出的排队任务，并唤醒其中挂起的任务。
+func (scope *Scope) launch(ready []scopeTask) {
+	for _, task := range ready {
+		if task.resume != nil {
+			close(task.resume)
+			continue
+		}
+		go scope.run(task)
+	}
+}
+
+// dequeueLocked 取出当前并发名额允许启动的排队任务，调用方必须持有锁。
+func (scope *Scope) dequeueLocked() []scopeTask {
+	if scope.closed || len(scope.queue) <= 0 {
+		return nil
+	}
+	n := len(scope.queue)
+	if scope.limit > 0 {
+		n = min(n, max(scope.limit-int(scope.running), 0))
+	}
+	if n <= 0 {
+		return nil
+	}
+	ready := scope.queue[:n:n]
+	scope.queue = scope.queue[n:]
+	scope.running += int64(n)
+	return ready
+}
+
+func (scope *Scope) recordRetry(update func(stats *ScopeStats)) {
+	scope.mu.Lock()
+	update(&scope.stats)
+	scope.mu.Unlock()
+}
+
 // Spawn 在 Scope 中启动后台任务并返回其一次性结果 Future。
-// panic 会转换为带堆栈的 Result.Error。
+// panic 会转换为带堆栈的 Result.Error。Scope 设置了并发上限时，任务可能先排队，或以 ErrScopeLimitExceeded 被拒绝。
 func Spawn(scope *Scope, task func(context.Context) Result) Future {
 	if task == nil {
 		exception.Panicf("%w: %w: async task is nil", ErrAsync, exception.ErrArgs)
 	}
-	if scope == nil || !scope.begin() {
-		return Rejected(ErrScopeClosed)
-	}
-
 	promise, future := NewPromise()
-	go func() {
-		defer scope.end()
-		promise.Resolve(safeTaskCall(task, scope.Context()))
-	}()
+	err := scope.start(scopeTask{
+		run:   func(ctx context.Context) { promise.Resolve(safeTaskCall(task, ctx)) },
+		abort: func(err error) { promise.Resolve(NewResult(nil, err)) },
+	})
+	if err != nil {
+		return Rejected(err)
+	}
 	return future
 }
 
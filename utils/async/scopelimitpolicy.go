@@ -0,0 +1,29 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+//go:generate stringer -type ScopeLimitPolicy
+package async
+
+// ScopeLimitPolicy 表示 Scope 活动任务达到并发上限后处理新任务的方式。
+type ScopeLimitPolicy int8
+
+const (
+	ScopeLimitPolicy_Queue  ScopeLimitPolicy = iota // ScopeLimitPolicy_Queue 表示新任务按 FIFO 排队，等待运行中的任务退出后启动。
+	ScopeLimitPolicy_Reject                         // ScopeLimitPolicy_Reject 表示立即以 ErrScopeLimitExceeded 拒绝新任务。
+)
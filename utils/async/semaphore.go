@@ -0,0 +1,177 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package async
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"git.golaxy.org/core/utils/exception"
+)
+
+// NewSemaphore 创建总权重为 size 的加权信号量。size 必须为正数。
+func NewSemaphore(size int64) *Semaphore {
+	if size <= 0 {
+		exception.Panicf("%w: %w: semaphore size must be positive", ErrAsync, exception.ErrArgs)
+	}
+	return &Semaphore{size: size}
+}
+
+// Semaphore 是按 FIFO 顺序分配权重的加权信号量，可用于限制多个 Scope 或任务共享的资源用量。
+//
+// Acquire 传入 Scope.Context() 时，Scope 关闭会唤醒等待者并返回 Scope 的取消原因。
+// 队首等待者权重不足时，后续等待者也不会插队，以避免大权重请求饥饿。
+type Semaphore struct {
+	mu      sync.Mutex
+	size    int64
+	used    int64
+	waiters []*semaphoreWaiter
+}
+
+type semaphoreWaiter struct {
+	n     int64
+	ready chan struct{}
+}
+
+// Size 返回信号量总权重。
+func (sem *Semaphore) Size() int64 {
+	return sem.size
+}
+
+// Used 返回当前已分配的权重。
+func (sem *Semaphore) Used() int64 {
+	sem.mu.Lock()
+	defer sem.mu.Unlock()
+	return sem.used
+}
+
+// Waiting 返回当前等待分配的请求数。
+func (sem *Semaphore) Waiting() int {
+	sem.mu.Lock()
+	defer sem.mu.Unlock()
+	return len(sem.waiters)
+}
+
+// Acquire 获取权重 n，阻塞直到成功或 ctx 取消。ctx 取消时返回 context.Cause(ctx)，不占用权重。
+// nil ctx 按 context.Background 处理；n 为负数或大于 Size 时会导致 panic。
+func (sem *Semaphore) Acquire(ctx context.Context, n int64) error {
+	sem.checkWeight(n)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	sem.mu.Lock()
+	if len(sem.waiters) <= 0 && sem.size-sem.used >= n {
+		sem.used += n
+		sem.mu.Unlock()
+		return nil
+	}
+	if ctx.Err() != nil {
+		sem.mu.Unlock()
+		return context.Cause(ctx)
+	}
+	waiter := &semaphoreWaiter{n: n, ready: make(chan struct{})}
+	sem.waiters = append(sem.waiters, waiter)
+	sem.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	sem.mu.Lock()
+	select {
+	case <-waiter.ready:
+		// 取消与分配竞争时分配已生效，归还权重以保持 ctx 取消的语义。
+		sem.used -= n
+		sem.notifyLocked()
+	default:
+		idx := slices.Index(sem.waiters, waiter)
+		sem.waiters = slices.Delete(sem.waiters, idx, idx+1)
+		if idx == 0 {
+			sem.notifyLocked()
+		}
+	}
+	sem.mu.Unlock()
+	return context.Cause(ctx)
+}
+
+// TryAcquire 无阻塞地获取权重 n，成功返回 true。存在等待者时不会插队。
+func (sem *Semaphore) TryAcquire(n int64) bool {
+	sem.checkWeight(n)
+	sem.mu.Lock()
+	defer sem.mu.Unlock()
+	if len(sem.waiters) > 0 || sem.size-sem.used < n {
+		return false
+	}
+	sem.used += n
+	return true
+}
+
+// Release 归还权重 n。归还超过已分配的权重会导致 panic。
+func (sem *Semaphore) Release(n int64) {
+	sem.mu.Lock()
+	defer sem.mu.Unlock()
+	if n < 0 || n > sem.used {
+		exception.Panicf("%w: %w: semaphore released more than held", ErrAsync, exception.ErrArgs)
+	}
+	sem.used -= n
+	sem.notifyLocked()
+}
+
+func (sem *Semaphore) checkWeight(n int64) {
+	if n < 0 || n > sem.size {
+		exception.Panicf("%w: %w: semaphore weight %d out of range [0, %d]", ErrAsync, exception.ErrArgs, n, sem.size)
+	}
+}
+
+func (sem *Semaphore) notifyLocked() {
+	for len(sem.waiters) > 0 {
+		waiter := sem.waiters[0]
+		if sem.size-sem.used < waiter.n {
+			return
+		}
+		sem.used += waiter.n
+		sem.waiters[0] = nil
+		sem.waiters = sem.waiters[1:]
+		close(waiter.ready)
+	}
+}
+
+// SpawnWeighted 在 Scope 中启动后台任务，任务先以 Scope.Context() 从 sem 获取权重 n，执行结束后归还。
+// 等待权重期间任务已登记在 Scope 中；Scope 关闭时放弃等待，并以 Scope 的取消原因完成。
+func SpawnWeighted(scope *Scope, sem *Semaphore, n int64, task func(context.Context) Result) Future {
+	if sem == nil {
+		exception.Panicf("%w: %w: semaphore is nil", ErrAsync, exception.ErrArgs)
+	}
+	if task == nil {
+		exception.Panicf("%w: %w: async task is nil", ErrAsync, exception.ErrArgs)
+	}
+	sem.checkWeight(n)
+	return Spawn(scope, func(ctx context.Context) Result {
+		if err := sem.Acquire(ctx, n); err != nil {
+			return NewResult(nil, err)
+		}
+		defer sem.Release(n)
+		return task(ctx)
+	})
+}
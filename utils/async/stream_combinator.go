@@ -0,0 +1,316 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package async
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"git.golaxy.org/core/utils/exception"
+)
+
+// MapStream 逐项转换源流结果。fn 的 panic 会转换为包含 ErrPanicked 的结果项。
+// 源流关闭后关闭返回流；ctx 取消时停止读取源流并关闭返回流。
+func MapStream(ctx context.Context, stream Stream, fn func(Result) Result) Stream {
+	checkStream(stream, "map")
+	if fn == nil {
+		exception.Panicf("%w: %w: stream map function is nil", ErrAsync, exception.ErrArgs)
+	}
+	return pipeStream(ctx, func(ctx context.Context, emitter Emitter) {
+		for {
+			ret, ok := recvStream(ctx, stream)
+			if !ok || !emitter.Emit(ctx, safeResultCall(fn, ret)) {
+				return
+			}
+		}
+	})
+}
+
+// FilterStream 只保留 pred 返回 true 的结果项。pred 的 panic 会转换为包含 ErrPanicked 的结果项。
+func FilterStream(ctx context.Context, stream Stream, pred func(Result) bool) Stream {
+	checkStream(stream, "filter")
+	if pred == nil {
+		exception.Panicf("%w: %w: stream filter predicate is nil", ErrAsync, exception.ErrArgs)
+	}
+	return pipeStream(ctx, func(ctx context.Context, emitter Emitter) {
+		for {
+			ret, ok := recvStream(ctx, stream)
+			if !ok {
+				return
+			}
+			keep, panicErr := safeBoolCall(pred, ret)
+			if panicErr != nil {
+				ret, keep = NewResult(nil, panicErr), true
+			}
+			if keep && !emitter.Emit(ctx, ret) {
+				return
+			}
+		}
+	})
+}
+
+// Merge 将多个源流的结果按到达顺序合并到一个流中，全部源流关闭后关闭返回流。
+// 空输入返回已关闭的流。
+func Merge(ctx context.Context, streams ...Stream) Stream {
+	for _, stream := range streams {
+		checkStream(stream, "merge")
+	}
+	return pipeStream(ctx, func(ctx context.Context, emitter Emitter) {
+		var wg sync.WaitGroup
+		for _, stream := range streams {
+			wg.Go(func() {
+				for {
+					ret, ok := recvStream(ctx, stream)
+					if !ok || !emitter.Emit(ctx, ret) {
+						return
+					}
+				}
+			})
+		}
+		wg.Wait()
+	})
+}
+
+// Batch 将成功结果的值攒批，以 []any 结果项发出；也称 Buffer。
+// 批次达到 n 项，或自批次首项起经过 dur 时发出；dur 不大于 0 时只按数量发出。
+// 失败结果项会先发出已攒的批次，再原样发出；源流关闭时发出剩余批次。
+func Batch(ctx context.Context, stream Stream, n int, dur time.Duration) Stream {
+	checkStream(stream, "batch")
+	if n <= 0 {
+		exception.Panicf("%w: %w: batch size must be positive", ErrAsync, exception.ErrArgs)
+	}
+	return pipeStream(ctx, func(ctx context.Context, emitter Emitter) {
+		var batch []any
+		var timer *time.Timer
+		var timeout <-chan time.Time
+
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+			if len(batch) <= 0 {
+				return true
+			}
+			values := batch
+			batch = nil
+			return emitter.Emit(ctx, NewResult(values, nil))
+		}
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		for {
+			select {
+			case ret, ok := <-stream.Chan():
+				if !ok {
+					flush()
+					return
+				}
+				if !ret.OK() {
+					if !flush() || !emitter.Emit(ctx, ret) {
+						return
+					}
+					continue
+				}
+				batch = append(batch, ret.Value)
+				if len(batch) >= n {
+					if !flush() {
+						return
+					}
+					continue
+				}
+				if len(batch) == 1 && dur > 0 {
+					timer = time.NewTimer(dur)
+					timeout = timer.C
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+// Throttle 每个 interval 内最多发出一项成功结果，其余成功结果被丢弃；失败结果项总是发出。
+func Throttle(ctx context.Context, stream Stream, interval time.Duration) Stream {
+	checkStream(stream, "throttle")
+	if interval <= 0 {
+		exception.Panicf("%w: %w: throttle interval must be positive", ErrAsync, exception.ErrArgs)
+	}
+	return pipeStream(ctx, func(ctx context.Context, emitter Emitter) {
+		var last time.Time
+		for {
+			ret, ok := recvStream(ctx, stream)
+			if !ok {
+				return
+			}
+			if ret.OK() {
+				now := time.Now()
+				if !last.IsZero() && now.Sub(last) < interval {
+					continue
+				}
+				last = now
+			}
+			if !emitter.Emit(ctx, ret) {
+				return
+			}
+		}
+	})
+}
+
+// Debounce 在源流静默 dur 后发出最近一项成功结果；失败结果项会先发出待定结果，再原样发出。
+// 源流关闭时发出待定结果。
+func Debounce(ctx context.Context, stream Stream, dur time.Duration) Stream {
+	checkStream(stream, "debounce")
+	if dur <= 0 {
+		exception.Panicf("%w: %w: debounce duration must be positive", ErrAsync, exception.ErrArgs)
+	}
+	return pipeStream(ctx, func(ctx context.Context, emitter Emitter) {
+		var pending Result
+		var hasPending bool
+		timer := time.NewTimer(dur)
+		timer.Stop()
+		defer timer.Stop()
+
+		flush := func() bool {
+			timer.Stop()
+			if !hasPending {
+				return true
+			}
+			ret := pending
+			pending, hasPending = Result{}, false
+			return emitter.Emit(ctx, ret)
+		}
+
+		for {
+			select {
+			case ret, ok := <-stream.Chan():
+				if !ok {
+					flush()
+					return
+				}
+				if !ret.OK() {
+					if !flush() || !emitter.Emit(ctx, ret) {
+						return
+					}
+					continue
+				}
+				pending, hasPending = ret, true
+				timer.Reset(dur)
+			case <-timer.C:
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+// Take 只发出源流的前 n 项结果，随后关闭返回流；不会关闭或继续读取源流。
+func Take(ctx context.Context, stream Stream, n int) Stream {
+	checkStream(stream, "take")
+	return pipeStream(ctx, func(ctx context.Context, emitter Emitter) {
+		for range n {
+			ret, ok := recvStream(ctx, stream)
+			if !ok || !emitter.Emit(ctx, ret) {
+				return
+			}
+		}
+	})
+}
+
+// Collect 读取源流直到关闭，并以按顺序收集的值 []any 完成 Future。
+// 遇到失败结果项时以该错误完成；ctx 取消时以 ctx.Err 完成。
+func Collect(ctx context.Context, stream Stream) Future {
+	checkStream(stream, "collect")
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	promise, future := NewPromise()
+	go func() {
+		values := []any{}
+		for {
+			select {
+			case ret, ok := <-stream.Chan():
+				if !ok {
+					promise.Resolve(NewResult(values, nil))
+					return
+				}
+				if !ret.OK() {
+					promise.Resolve(NewResult(nil, ret.Error))
+					return
+				}
+				values = append(values, ret.Value)
+			case <-ctx.Done():
+				promise.Resolve(NewResult(nil, ctx.Err()))
+				return
+			}
+		}
+	}()
+	return future
+}
+
+func checkStream(stream Stream, op string) {
+	if stream.IsNil() {
+		exception.Panicf("%w: %w: stream is nil, cannot %s", ErrAsync, exception.ErrArgs, op)
+	}
+}
+
+// pipeStream 在新 goroutine 中运行 body，body 返回后关闭返回流。
+func pipeStream(ctx context.Context, body func(ctx context.Context, emitter Emitter)) Stream {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	emitter, stream := NewStream()
+	go func() {
+		defer emitter.Close()
+		body(ctx, emitter)
+	}()
+	return stream
+}
+
+// recvStream 读取下一项结果；源流关闭或 ctx 取消时返回 false。
+func recvStream(ctx context.Context, stream Stream) (Result, bool) {
+	select {
+	case ret, ok := <-stream.Chan():
+		return ret, ok
+	case <-ctx.Done():
+		return Result{}, false
+	}
+}
+
+func safeBoolCall(fn func(Result) bool, input Result) (ret bool, panicErr error) {
+	defer func() {
+		if panicValue := recover(); panicValue != nil {
+			panicErr = panicToError(panicValue)
+		}
+	}()
+	return fn(input), nil
+}
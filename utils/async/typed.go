@@ -0,0 +1,201 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package async
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"git.golaxy.org/core/utils/exception"
+)
+
+// NewTypedPromise 创建一对值类型为 T 的 TypedPromise 和 TypedFuture，completionExecutorID 语义同 NewPromise。
+func NewTypedPromise[T any](completionExecutorID ...ExecutorID) (TypedPromise[T], TypedFuture[T]) {
+	promise, future := NewPromise(completionExecutorID...)
+	return TypedPromise[T]{promise: promise}, TypedFuture[T]{future: future}
+}
+
+// TypedResolved 创建已经以 value 完成的 TypedFuture。
+func TypedResolved[T any](value T) TypedFuture[T] {
+	return AsTyped[T](Resolved(NewResult(value, nil)))
+}
+
+// TypedRejected 创建已经以 err 失败的 TypedFuture。
+func TypedRejected[T any](err error) TypedFuture[T] {
+	return AsTyped[T](Rejected(err))
+}
+
+// AsTyped 将 future 包装为值类型为 T 的 TypedFuture，二者共享同一完成状态。
+// 成功结果的值无法断言为 T 时，读取结果返回 ErrResultTypeMismatch；nil 值读取为 T 的零值。
+func AsTyped[T any](future Future) TypedFuture[T] {
+	return TypedFuture[T]{future: future}
+}
+
+// TypedPromise 是 Promise 的类型化包装，只能以 T 类型的值完成。
+type TypedPromise[T any] struct {
+	promise Promise
+}
+
+// IsNil 报告 TypedPromise 是否为零值。
+func (promise TypedPromise[T]) IsNil() bool {
+	return promise.promise.IsNil()
+}
+
+// Promise 返回底层非泛型 Promise。
+func (promise TypedPromise[T]) Promise() Promise {
+	return promise.promise
+}
+
+// Future 返回与 TypedPromise 共享状态的 TypedFuture。
+func (promise TypedPromise[T]) Future() TypedFuture[T] {
+	return TypedFuture[T]{future: promise.promise.Future()}
+}
+
+// Resolve 以 value 成功完成 Future。首次完成返回 true，后续调用返回 false。
+func (promise TypedPromise[T]) Resolve(value T) bool {
+	return promise.promise.Resolve(NewResult(value, nil))
+}
+
+// Reject 以 err 失败完成 Future。首次完成返回 true，后续调用返回 false。
+func (promise TypedPromise[T]) Reject(err error) bool {
+	return promise.promise.Resolve(NewResult(nil, err))
+}
+
+// TypedFuture 是 Future 的类型化只读视图，读取结果时无需再断言 Result.Value。
+//
+// Wait 直接委托 Future.Wait，因此同样遵循 WaitGuard 的 Runtime 自等待检测。
+type TypedFuture[T any] struct {
+	future Future
+}
+
+// IsNil 报告 TypedFuture 是否为零值。
+func (future TypedFuture[T]) IsNil() bool {
+	return future.future.IsNil()
+}
+
+// Future 返回底层非泛型 Future，可用于 Race、All 等非泛型组合器。
+func (future TypedFuture[T]) Future() Future {
+	return future.future
+}
+
+// ID 返回 Future 的进程内诊断 ID；零值返回 0。
+func (future TypedFuture[T]) ID() FutureID {
+	return future.future.ID()
+}
+
+// CompletionExecutorID 返回完成 Future 所依赖的执行器 ID。
+func (future TypedFuture[T]) CompletionExecutorID() ExecutorID {
+	return future.future.CompletionExecutorID()
+}
+
+// Done 返回 Future 完成时关闭的共享频道；零值会导致 panic。
+func (future TypedFuture[T]) Done() <-chan struct{} {
+	return future.future.Done()
+}
+
+// TryGet 无阻塞地读取完成结果。Future 尚未完成时 ok 为 false。
+func (future TypedFuture[T]) TryGet() (value T, err error, ok bool) {
+	ret, ok := future.future.TryGet()
+	if !ok {
+		return value, nil, false
+	}
+	value, err = castResult[T](ret)
+	return value, err, true
+}
+
+// Wait 等待 Future 完成或 ctx 取消，返回类型化的值与错误。语义同 Future.Wait。
+func (future TypedFuture[T]) Wait(ctx context.Context) (T, error) {
+	return castResult[T](future.future.Wait(ctx))
+}
+
+// OnComplete 订阅 Future 完成，语义同 Future.OnComplete。callback 为 nil 时会导致 panic。
+func (future TypedFuture[T]) OnComplete(callback func(value T, err error)) Subscription {
+	if callback == nil {
+		exception.Panicf("%w: %w: future completion callback is nil", ErrAsync, exception.ErrArgs)
+	}
+	return future.future.OnComplete(func(ret Result) {
+		callback(castResult[T](ret))
+	})
+}
+
+// Context 返回由 ctx 派生、并在 Future 完成时取消的上下文。
+func (future TypedFuture[T]) Context(ctx context.Context) context.Context {
+	return future.future.Context(ctx)
+}
+
+// TypedRace 是 Race 的类型化版本。
+func TypedRace[T any](futures ...TypedFuture[T]) TypedFuture[T] {
+	return AsTyped[T](Race(untypedFutures(futures)...))
+}
+
+// TypedAll 是 All 的类型化版本，按输入顺序收集 []T；任一 Future 失败或值类型不匹配时失败。
+// 空输入成功返回空 []T。
+func TypedAll[T any](futures ...TypedFuture[T]) TypedFuture[[]T] {
+	return TypedMap(AsTyped[[]any](All(untypedFutures(futures)...)), func(values []any) ([]T, error) {
+		typed := make([]T, len(values))
+		for i, value := range values {
+			v, err := castResult[T](NewResult(value, nil))
+			if err != nil {
+				return nil, err
+			}
+			typed[i] = v
+		}
+		return typed, nil
+	})
+}
+
+// TypedMap 在源 Future 成功完成的 goroutine 中以 fn 转换值；源失败时直接传递错误而不调用 fn。
+// fn 必须快速返回，其 panic 转换为包含 ErrPanicked 的错误。
+func TypedMap[T, R any](future TypedFuture[T], fn func(T) (R, error)) TypedFuture[R] {
+	if fn == nil {
+		exception.Panicf("%w: %w: future map function is nil", ErrAsync, exception.ErrArgs)
+	}
+	return AsTyped[R](Map(future.future, func(ret Result) Result {
+		value, err := castResult[T](ret)
+		if err != nil {
+			return NewResult(nil, err)
+		}
+		return NewResult(fn(value))
+	}))
+}
+
+func untypedFutures[T any](futures []TypedFuture[T]) []Future {
+	untyped := make([]Future, len(futures))
+	for i := range futures {
+		untyped[i] = futures[i].future
+	}
+	return untyped
+}
+
+func castResult[T any](ret Result) (T, error) {
+	var zero T
+	if !ret.OK() {
+		return zero, ret.Error
+	}
+	if ret.Value == nil {
+		return zero, nil
+	}
+	value, ok := ret.Value.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: %T is not %s", ErrResultTypeMismatch, ret.Value, reflect.TypeFor[T]())
+	}
+	return value, nil
+}
@@ -21,8 +21,10 @@ package corectx
 
 import (
 	"context"
+	"log/slog"
 
 	"git.golaxy.org/core/utils/async"
+	"git.golaxy.org/core/utils/exception"
 	"git.golaxy.org/core/utils/generic"
 )
 
@@ -52,6 +54,11 @@ type Context interface {
 	AutoRecover() bool
 	// ReportError 返回自动恢复 panic 后用于非阻塞上报错误的频道。
 	ReportError() chan error
+	// PanicReporter 返回自动恢复 panic 时使用的上报函数，应与 AutoRecover 一同传给 generic 调用与事件。
+	// panic 在 recover 处以 Error 级别记录日志后，非阻塞地写入 ReportError。
+	PanicReporter() exception.PanicReporter
+	// Logger 返回附带上下文属性的结构化日志记录器；未配置日志记录器时丢弃全部输出。
+	Logger() *slog.Logger
 	// WaitGroup 返回用于协调关闭的任务屏障。
 	WaitGroup() WaitGroup
 	// Terminate 发出取消信号，并返回宿主完成清理时兑现的 Signal。
@@ -61,7 +68,7 @@ type Context interface {
 }
 
 type iContext interface {
-	init(parentCtx context.Context, autoRecover bool, reportError chan error)
+	init(parentCtx context.Context, autoRecover bool, reportError chan error, logger *slog.Logger)
 	closeWaitGroup()
 	returnTerminated()
 }
@@ -69,12 +76,14 @@ type iContext interface {
 // ContextBehavior 提供 Context 的通用实现，供 Service 与 Runtime 上下文嵌入。
 type ContextBehavior struct {
 	context.Context
-	parentCtx   context.Context
-	autoRecover bool
-	reportError chan error
-	barrier     generic.Barrier
-	asyncScope  *async.Scope
-	terminated  async.Completer
+	parentCtx     context.Context
+	autoRecover   bool
+	reportError   chan error
+	panicReporter exception.PanicReporter
+	logger        *slog.Logger
+	barrier       generic.Barrier
+	asyncScope    *async.Scope
+	terminated    async.Completer
 }
 
 // ParentContext 返回创建当前上下文时使用的父上下文。
@@ -92,6 +101,17 @@ func (ctx *ContextBehavior) ReportError() chan error {
 	return ctx.reportError
 }
 
+// PanicReporter 返回自动恢复 panic 时使用的上报函数，应与 AutoRecover 一同传给 generic 调用与事件。
+// panic 在 recover 处以 Error 级别记录日志后，非阻塞地写入 ReportError。
+func (ctx *ContextBehavior) PanicReporter() exception.PanicReporter {
+	return ctx.panicReporter
+}
+
+// Logger 返回附带上下文属性的结构化日志记录器；未配置日志记录器时丢弃全部输出。
+func (ctx *ContextBehavior) Logger() *slog.Logger {
+	return ctx.logger
+}
+
 // WaitGroup 返回用于协调关闭的任务屏障。
 func (ctx *ContextBehavior) WaitGroup() WaitGroup {
 	return &ctx.barrier
@@ -113,14 +133,19 @@ func (ctx *ContextBehavior) Terminated() async.Signal {
 	return ctx.terminated.Signal()
 }
 
-func (ctx *ContextBehavior) init(parentCtx context.Context, autoRecover bool, reportError chan error) {
+func (ctx *ContextBehavior) init(parentCtx context.Context, autoRecover bool, reportError chan error, logger *slog.Logger) {
 	if parentCtx == nil {
 		ctx.parentCtx = context.Background()
 	} else {
 		ctx.parentCtx = parentCtx
 	}
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
 	ctx.autoRecover = autoRecover
 	ctx.reportError = reportError
+	ctx.panicReporter = ctx.reportPanic
+	ctx.logger = logger
 	ctx.asyncScope = async.NewScope(ctx.parentCtx)
 	ctx.Context = ctx.asyncScope.Context()
 	ctx.terminated, _ = async.NewSignal()
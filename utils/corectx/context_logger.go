@@ -0,0 +1,41 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package corectx
+
+import (
+	"errors"
+
+	"git.golaxy.org/core/utils/exception"
+)
+
+// reportPanic 在 recover 处以 Error 级别记录 panic 及其堆栈，再非阻塞地写入 ReportError。
+func (ctx *ContextBehavior) reportPanic(err error) {
+	var stackErr *exception.ErrorWithStack
+	if errors.As(err, &stackErr) {
+		ctx.logger.Error("panic recovered", "error", stackErr.Err, "stack", string(stackErr.Stack))
+	} else {
+		ctx.logger.Error("panic recovered", "error", err)
+	}
+
+	select {
+	case ctx.reportError <- err:
+	default:
+	}
+}
@@ -23,6 +23,7 @@ Package corectx 抽象了框架中通用的上下文能力，包括：
 
   - 父 context、终止与完成信号；
   - panic 自动恢复与错误上报策略；
+  - 结构化日志记录器，以及自动恢复 panic 的堆栈日志；
   - 等待组/屏障，用于协调 service 与 runtime 的关闭顺序；
   - 当前上下文和并发安全上下文提供器接口。
 
@@ -21,6 +21,7 @@ package corectx
 
 import (
 	"context"
+	"log/slog"
 )
 
 // UnsafeContext 暴露上下文初始化与完成信号等框架内部能力。
@@ -36,9 +37,9 @@ type _UnsafeContext struct {
 	Context
 }
 
-// Init 使用父上下文及 panic 处理策略初始化上下文。
-func (u _UnsafeContext) Init(parentCtx context.Context, autoRecover bool, reportError chan error) {
-	u.init(parentCtx, autoRecover, reportError)
+// Init 使用父上下文、panic 处理策略及日志记录器初始化上下文。
+func (u _UnsafeContext) Init(parentCtx context.Context, autoRecover bool, reportError chan error, logger *slog.Logger) {
+	u.init(parentCtx, autoRecover, reportError, logger)
 }
 
 // CloseWaitGroup 关闭任务屏障，使其不再接受新任务。
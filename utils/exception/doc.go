@@ -24,5 +24,8 @@ Package exception 用于统一构造框架内部错误。它会把调用文件
 
 框架内的参数检查与不变量校验通常通过 Panic / Panicf 抛出；需要向上返回 error
 时则使用 Error / Errorf 系列函数。
+
+ReportPanic 在 recover 处为 panic 附加堆栈并交给 PanicReporter；ReportTo 将错误频道
+适配为 PanicReporter。
 */
 package exception
@@ -0,0 +1,45 @@
+/*
+ * This file is part of Golaxy Distributed Service Development Framework.
+ *
+ * Golaxy Distributed Service Development Framework is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 2.1 of the License, or
+ * (at your option) any later version.
+ *
+ * Golaxy Distributed Service Development Framework is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with Golaxy Distributed Service Development Framework. If not, see <http://www.gnu.org/licenses/>.
+ *
+ * Copyright (c) 2024 pangdogs.
+ */
+
+package exception
+
+// PanicReporter 接收自动恢复的 panic，在 recover 所在的 defer 中同步调用；nil 表示丢弃。
+type PanicReporter func(err error)
+
+// ReportPanic 为恢复 panic 得到的 err 附加当前协程堆栈后交给 reporter；reporter 为 nil 时直接返回。
+// 应在 recover 所在的 defer 中调用，使堆栈包含 panic 位置。
+func ReportPanic(reporter PanicReporter, err error) {
+	if reporter == nil {
+		return
+	}
+	reporter(TraceStack(err))
+}
+
+// ReportTo 返回非阻塞写入 reportError 的 PanicReporter；reportError 为 nil 时返回 nil。
+func ReportTo(reportError chan error) PanicReporter {
+	if reportError == nil {
+		return nil
+	}
+	return func(err error) {
+		select {
+		case reportError <- err:
+		default:
+		}
+	}
+}
@@ -36,7 +36,7 @@ func (f Action0) SafeCall() (panicErr error) {
 	return f.Call(true, nil)
 }
 
-func (f Action0) Call(autoRecover bool, reportError chan error) (panicErr error) {
+func (f Action0) Call(autoRecover bool, reportPanic exception.PanicReporter) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -44,10 +44,7 @@ func (f Action0) Call(autoRecover bool, reportError chan error) (panicErr error)
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -71,7 +68,7 @@ func (f Action1[A1]) SafeCall(a1 A1) (panicErr error) {
 	return f.Call(true, nil, a1)
 }
 
-func (f Action1[A1]) Call(autoRecover bool, reportError chan error, a1 A1) (panicErr error) {
+func (f Action1[A1]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -79,10 +76,7 @@ func (f Action1[A1]) Call(autoRecover bool, reportError chan error, a1 A1) (pani
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -106,7 +100,7 @@ func (f Action2[A1, A2]) SafeCall(a1 A1, a2 A2) (panicErr error) {
 	return f.Call(true, nil, a1, a2)
 }
 
-func (f Action2[A1, A2]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2) (panicErr error) {
+func (f Action2[A1, A2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -114,10 +108,7 @@ func (f Action2[A1, A2]) Call(autoRecover bool, reportError chan error, a1 A1, a
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -141,7 +132,7 @@ func (f Action3[A1, A2, A3]) SafeCall(a1 A1, a2 A2, a3 A3) (panicErr error) {
 	return f.Call(true, nil, a1, a2, a3)
 }
 
-func (f Action3[A1, A2, A3]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3) (panicErr error) {
+func (f Action3[A1, A2, A3]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -149,10 +140,7 @@ func (f Action3[A1, A2, A3]) Call(autoRecover bool, reportError chan error, a1 A
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -176,7 +164,7 @@ func (f Action4[A1, A2, A3, A4]) SafeCall(a1 A1, a2 A2, a3 A3, a4 A4) (panicErr
 	return f.Call(true, nil, a1, a2, a3, a4)
 }
 
-func (f Action4[A1, A2, A3, A4]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3, a4 A4) (panicErr error) {
+func (f Action4[A1, A2, A3, A4]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3, a4 A4) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -184,10 +172,7 @@ func (f Action4[A1, A2, A3, A4]) Call(autoRecover bool, reportError chan error,
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -218,7 +203,7 @@ func (f Action5[A1, A2, A3, A4, A5]) SafeCall(
 }
 
 func (f Action5[A1, A2, A3, A4, A5]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5,
 ) (panicErr error) {
 	if f == nil {
@@ -228,10 +213,7 @@ func (f Action5[A1, A2, A3, A4, A5]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -262,7 +244,7 @@ func (f Action6[A1, A2, A3, A4, A5, A6]) SafeCall(
 }
 
 func (f Action6[A1, A2, A3, A4, A5, A6]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6,
 ) (panicErr error) {
 	if f == nil {
@@ -272,10 +254,7 @@ func (f Action6[A1, A2, A3, A4, A5, A6]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -306,7 +285,7 @@ func (f Action7[A1, A2, A3, A4, A5, A6, A7]) SafeCall(
 }
 
 func (f Action7[A1, A2, A3, A4, A5, A6, A7]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7,
 ) (panicErr error) {
 	if f == nil {
@@ -316,10 +295,7 @@ func (f Action7[A1, A2, A3, A4, A5, A6, A7]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -350,7 +326,7 @@ func (f Action8[A1, A2, A3, A4, A5, A6, A7, A8]) SafeCall(
 }
 
 func (f Action8[A1, A2, A3, A4, A5, A6, A7, A8]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8,
 ) (panicErr error) {
 	if f == nil {
@@ -360,10 +336,7 @@ func (f Action8[A1, A2, A3, A4, A5, A6, A7, A8]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -394,7 +367,7 @@ func (f Action9[A1, A2, A3, A4, A5, A6, A7, A8, A9]) SafeCall(
 }
 
 func (f Action9[A1, A2, A3, A4, A5, A6, A7, A8, A9]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9,
 ) (panicErr error) {
 	if f == nil {
@@ -404,10 +377,7 @@ func (f Action9[A1, A2, A3, A4, A5, A6, A7, A8, A9]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -438,7 +408,7 @@ func (f Action10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10]) SafeCall(
 }
 
 func (f Action10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10,
 ) (panicErr error) {
 	if f == nil {
@@ -448,10 +418,7 @@ func (f Action10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -482,7 +449,7 @@ func (f Action11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11]) SafeCall(
 }
 
 func (f Action11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11,
 ) (panicErr error) {
 	if f == nil {
@@ -494,12 +461,7 @@ func (f Action11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11]) Call(
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -530,7 +492,7 @@ func (f Action12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12]) SafeCall(
 }
 
 func (f Action12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12,
 ) (panicErr error) {
 	if f == nil {
@@ -542,12 +504,7 @@ func (f Action12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12]) Call(
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -578,7 +535,7 @@ func (f Action13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13]) SafeCa
 }
 
 func (f Action13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13,
 ) (panicErr error) {
 	if f == nil {
@@ -590,12 +547,7 @@ func (f Action13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13]) Call(
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -626,7 +578,7 @@ func (f Action14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14]) S
 }
 
 func (f Action14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14,
 ) (panicErr error) {
 	if f == nil {
@@ -638,12 +590,7 @@ func (f Action14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14]) C
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -674,7 +621,7 @@ func (f Action15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A1
 }
 
 func (f Action15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15,
 ) (panicErr error) {
 	if f == nil {
@@ -686,12 +633,7 @@ func (f Action15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A1
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -722,7 +664,7 @@ func (f Action16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A1
 }
 
 func (f Action16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16,
 ) (panicErr error) {
 	if f == nil {
@@ -734,12 +676,7 @@ func (f Action16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A1
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -36,7 +36,7 @@ func (f ActionVar0[VA]) SafeCall(args ...VA) (panicErr error) {
 	return f.Call(true, nil, args...)
 }
 
-func (f ActionVar0[VA]) Call(autoRecover bool, reportError chan error, args ...VA) (panicErr error) {
+func (f ActionVar0[VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, args ...VA) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -44,10 +44,7 @@ func (f ActionVar0[VA]) Call(autoRecover bool, reportError chan error, args ...V
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -71,7 +68,7 @@ func (f ActionVar1[A1, VA]) SafeCall(a1 A1, args ...VA) (panicErr error) {
 	return f.Call(true, nil, a1, args...)
 }
 
-func (f ActionVar1[A1, VA]) Call(autoRecover bool, reportError chan error, a1 A1, args ...VA) (panicErr error) {
+func (f ActionVar1[A1, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, args ...VA) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -79,10 +76,7 @@ func (f ActionVar1[A1, VA]) Call(autoRecover bool, reportError chan error, a1 A1
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -106,7 +100,7 @@ func (f ActionVar2[A1, A2, VA]) SafeCall(a1 A1, a2 A2, args ...VA) (panicErr err
 	return f.Call(true, nil, a1, a2, args...)
 }
 
-func (f ActionVar2[A1, A2, VA]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, args ...VA) (panicErr error) {
+func (f ActionVar2[A1, A2, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, args ...VA) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -114,10 +108,7 @@ func (f ActionVar2[A1, A2, VA]) Call(autoRecover bool, reportError chan error, a
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -141,7 +132,7 @@ func (f ActionVar3[A1, A2, A3, VA]) SafeCall(a1 A1, a2 A2, a3 A3, args ...VA) (p
 	return f.Call(true, nil, a1, a2, a3, args...)
 }
 
-func (f ActionVar3[A1, A2, A3, VA]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3, args ...VA) (panicErr error) {
+func (f ActionVar3[A1, A2, A3, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3, args ...VA) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -149,10 +140,7 @@ func (f ActionVar3[A1, A2, A3, VA]) Call(autoRecover bool, reportError chan erro
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -176,7 +164,7 @@ func (f ActionVar4[A1, A2, A3, A4, VA]) SafeCall(a1 A1, a2 A2, a3 A3, a4 A4, arg
 	return f.Call(true, nil, a1, a2, a3, a4, args...)
 }
 
-func (f ActionVar4[A1, A2, A3, A4, VA]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (panicErr error) {
+func (f ActionVar4[A1, A2, A3, A4, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (panicErr error) {
 	if f == nil {
 		return nil
 	}
@@ -184,10 +172,7 @@ func (f ActionVar4[A1, A2, A3, A4, VA]) Call(autoRecover bool, reportError chan
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -218,7 +203,7 @@ func (f ActionVar5[A1, A2, A3, A4, A5, VA]) SafeCall(
 }
 
 func (f ActionVar5[A1, A2, A3, A4, A5, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -228,10 +213,7 @@ func (f ActionVar5[A1, A2, A3, A4, A5, VA]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -262,7 +244,7 @@ func (f ActionVar6[A1, A2, A3, A4, A5, A6, VA]) SafeCall(
 }
 
 func (f ActionVar6[A1, A2, A3, A4, A5, A6, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -272,10 +254,7 @@ func (f ActionVar6[A1, A2, A3, A4, A5, A6, VA]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -306,7 +285,7 @@ func (f ActionVar7[A1, A2, A3, A4, A5, A6, A7, VA]) SafeCall(
 }
 
 func (f ActionVar7[A1, A2, A3, A4, A5, A6, A7, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -316,10 +295,7 @@ func (f ActionVar7[A1, A2, A3, A4, A5, A6, A7, VA]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -350,7 +326,7 @@ func (f ActionVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA]) SafeCall(
 }
 
 func (f ActionVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -360,10 +336,7 @@ func (f ActionVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -394,7 +367,7 @@ func (f ActionVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA]) SafeCall(
 }
 
 func (f ActionVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -404,10 +377,7 @@ func (f ActionVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -438,7 +408,7 @@ func (f ActionVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA]) SafeCall(
 }
 
 func (f ActionVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -448,10 +418,7 @@ func (f ActionVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -482,7 +449,7 @@ func (f ActionVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA]) SafeCall(
 }
 
 func (f ActionVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -494,12 +461,7 @@ func (f ActionVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA]) Call(
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -530,7 +492,7 @@ func (f ActionVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA]) Safe
 }
 
 func (f ActionVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -542,12 +504,7 @@ func (f ActionVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA]) Call
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -578,7 +535,7 @@ func (f ActionVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA])
 }
 
 func (f ActionVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -590,12 +547,7 @@ func (f ActionVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA])
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -626,7 +578,7 @@ func (f ActionVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (f ActionVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -638,12 +590,7 @@ func (f ActionVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -674,7 +621,7 @@ func (f ActionVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (f ActionVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -686,12 +633,7 @@ func (f ActionVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -722,7 +664,7 @@ func (f ActionVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (f ActionVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, VA]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16, args ...VA,
 ) (panicErr error) {
 	if f == nil {
@@ -734,12 +676,7 @@ func (f ActionVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
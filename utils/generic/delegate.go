@@ -19,6 +19,8 @@
 
 package generic
 
+import "git.golaxy.org/core/utils/exception"
+
 type Delegate0[R any] []Func0[R]
 
 func (d Delegate0[R]) UnsafeCall(interrupt Func2[R, error, bool]) (r R) {
@@ -30,13 +32,13 @@ func (d Delegate0[R]) SafeCall(interrupt Func2[R, error, bool]) (r R, panicErr e
 	return d.Call(true, nil, interrupt)
 }
 
-func (d Delegate0[R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool]) (r R, panicErr error) {
+func (d Delegate0[R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool]) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError)
+		r, panicErr = d[i].Call(autoRecover, reportPanic)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -64,13 +66,13 @@ func (d Delegate1[A1, R]) SafeCall(interrupt Func2[R, error, bool], a1 A1) (r R,
 	return d.Call(true, nil, interrupt, a1)
 }
 
-func (d Delegate1[A1, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1) (r R, panicErr error) {
+func (d Delegate1[A1, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -98,13 +100,13 @@ func (d Delegate2[A1, A2, R]) SafeCall(interrupt Func2[R, error, bool], a1 A1, a
 	return d.Call(true, nil, interrupt, a1, a2)
 }
 
-func (d Delegate2[A1, A2, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1, a2 A2) (r R, panicErr error) {
+func (d Delegate2[A1, A2, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1, a2 A2) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -128,13 +130,13 @@ func (d Delegate3[A1, A2, A3, R]) SafeCall(interrupt Func2[R, error, bool], a1 A
 	return d.Call(true, nil, interrupt, a1, a2, a3)
 }
 
-func (d Delegate3[A1, A2, A3, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3) (r R, panicErr error) {
+func (d Delegate3[A1, A2, A3, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -162,13 +164,13 @@ func (d Delegate4[A1, A2, A3, A4, R]) SafeCall(interrupt Func2[R, error, bool],
 	return d.Call(true, nil, interrupt, a1, a2, a3, a4)
 }
 
-func (d Delegate4[A1, A2, A3, A4, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3, a4 A4) (r R, panicErr error) {
+func (d Delegate4[A1, A2, A3, A4, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3, a4 A4) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -203,7 +205,7 @@ func (d Delegate5[A1, A2, A3, A4, A5, R]) SafeCall(interrupt Func2[R, error, boo
 }
 
 func (d Delegate5[A1, A2, A3, A4, A5, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -211,7 +213,7 @@ func (d Delegate5[A1, A2, A3, A4, A5, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -246,7 +248,7 @@ func (d Delegate6[A1, A2, A3, A4, A5, A6, R]) SafeCall(interrupt Func2[R, error,
 }
 
 func (d Delegate6[A1, A2, A3, A4, A5, A6, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -254,7 +256,7 @@ func (d Delegate6[A1, A2, A3, A4, A5, A6, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -291,7 +293,7 @@ func (d Delegate7[A1, A2, A3, A4, A5, A6, A7, R]) SafeCall(interrupt Func2[R, er
 }
 
 func (d Delegate7[A1, A2, A3, A4, A5, A6, A7, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -299,7 +301,7 @@ func (d Delegate7[A1, A2, A3, A4, A5, A6, A7, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -336,7 +338,7 @@ func (d Delegate8[A1, A2, A3, A4, A5, A6, A7, A8, R]) SafeCall(interrupt Func2[R
 }
 
 func (d Delegate8[A1, A2, A3, A4, A5, A6, A7, A8, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -344,7 +346,7 @@ func (d Delegate8[A1, A2, A3, A4, A5, A6, A7, A8, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -381,7 +383,7 @@ func (d Delegate9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R]) SafeCall(interrupt Fun
 }
 
 func (d Delegate9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -389,7 +391,7 @@ func (d Delegate9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -426,7 +428,7 @@ func (d Delegate10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R]) SafeCall(interru
 }
 
 func (d Delegate10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -434,7 +436,7 @@ func (d Delegate10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -471,7 +473,7 @@ func (d Delegate11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R]) SafeCall(in
 }
 
 func (d Delegate11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -479,7 +481,7 @@ func (d Delegate11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -516,7 +518,7 @@ func (d Delegate12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R]) SafeCa
 }
 
 func (d Delegate12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -524,7 +526,7 @@ func (d Delegate12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -561,7 +563,7 @@ func (d Delegate13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R]) S
 }
 
 func (d Delegate13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -569,7 +571,7 @@ func (d Delegate13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R]) C
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -606,7 +608,7 @@ func (d Delegate14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (d Delegate14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -614,7 +616,7 @@ func (d Delegate14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -651,7 +653,7 @@ func (d Delegate15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (d Delegate15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -659,7 +661,7 @@ func (d Delegate15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -696,7 +698,7 @@ func (d Delegate16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (d Delegate16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -704,7 +706,7 @@ func (d Delegate16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -19,6 +19,8 @@
 
 package generic
 
+import "git.golaxy.org/core/utils/exception"
+
 type DelegatePair0[R1, R2 any] []FuncPair0[R1, R2]
 
 func (d DelegatePair0[R1, R2]) UnsafeCall(interrupt Func3[R1, R2, error, bool]) (r1 R1, r2 R2) {
@@ -30,13 +32,13 @@ func (d DelegatePair0[R1, R2]) SafeCall(interrupt Func3[R1, R2, error, bool]) (r
 	return d.Call(true, nil, interrupt)
 }
 
-func (d DelegatePair0[R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool]) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePair0[R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool]) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -64,13 +66,13 @@ func (d DelegatePair1[A1, R1, R2]) SafeCall(interrupt Func3[R1, R2, error, bool]
 	return d.Call(true, nil, interrupt, a1)
 }
 
-func (d DelegatePair1[A1, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePair1[A1, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -98,13 +100,13 @@ func (d DelegatePair2[A1, A2, R1, R2]) SafeCall(interrupt Func3[R1, R2, error, b
 	return d.Call(true, nil, interrupt, a1, a2)
 }
 
-func (d DelegatePair2[A1, A2, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePair2[A1, A2, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -132,13 +134,13 @@ func (d DelegatePair3[A1, A2, A3, R1, R2]) SafeCall(interrupt Func3[R1, R2, erro
 	return d.Call(true, nil, interrupt, a1, a2, a3)
 }
 
-func (d DelegatePair3[A1, A2, A3, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePair3[A1, A2, A3, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -166,13 +168,13 @@ func (d DelegatePair4[A1, A2, A3, A4, R1, R2]) SafeCall(interrupt Func3[R1, R2,
 	return d.Call(true, nil, interrupt, a1, a2, a3, a4)
 }
 
-func (d DelegatePair4[A1, A2, A3, A4, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePair4[A1, A2, A3, A4, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -207,7 +209,7 @@ func (d DelegatePair5[A1, A2, A3, A4, A5, R1, R2]) SafeCall(interrupt Func3[R1,
 }
 
 func (d DelegatePair5[A1, A2, A3, A4, A5, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -215,7 +217,7 @@ func (d DelegatePair5[A1, A2, A3, A4, A5, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -250,7 +252,7 @@ func (d DelegatePair6[A1, A2, A3, A4, A5, A6, R1, R2]) SafeCall(interrupt Func3[
 }
 
 func (d DelegatePair6[A1, A2, A3, A4, A5, A6, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -258,7 +260,7 @@ func (d DelegatePair6[A1, A2, A3, A4, A5, A6, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -295,7 +297,7 @@ func (d DelegatePair7[A1, A2, A3, A4, A5, A6, A7, R1, R2]) SafeCall(interrupt Fu
 }
 
 func (d DelegatePair7[A1, A2, A3, A4, A5, A6, A7, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -303,7 +305,7 @@ func (d DelegatePair7[A1, A2, A3, A4, A5, A6, A7, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -340,7 +342,7 @@ func (d DelegatePair8[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2]) SafeCall(interrup
 }
 
 func (d DelegatePair8[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -348,7 +350,7 @@ func (d DelegatePair8[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -385,7 +387,7 @@ func (d DelegatePair9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2]) SafeCall(inte
 }
 
 func (d DelegatePair9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -393,7 +395,7 @@ func (d DelegatePair9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -430,7 +432,7 @@ func (d DelegatePair10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R1, R2]) SafeCal
 }
 
 func (d DelegatePair10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -438,7 +440,7 @@ func (d DelegatePair10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -475,7 +477,7 @@ func (d DelegatePair11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R1, R2]) Sa
 }
 
 func (d DelegatePair11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -483,7 +485,7 @@ func (d DelegatePair11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R1, R2]) Ca
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -520,7 +522,7 @@ func (d DelegatePair12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R1, R2
 }
 
 func (d DelegatePair12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -528,7 +530,7 @@ func (d DelegatePair12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R1, R2
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -565,7 +567,7 @@ func (d DelegatePair13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R
 }
 
 func (d DelegatePair13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -573,7 +575,7 @@ func (d DelegatePair13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -610,7 +612,7 @@ func (d DelegatePair14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 }
 
 func (d DelegatePair14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -618,7 +620,7 @@ func (d DelegatePair14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -655,7 +657,7 @@ func (d DelegatePair15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 }
 
 func (d DelegatePair15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -663,7 +665,7 @@ func (d DelegatePair15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -700,7 +702,7 @@ func (d DelegatePair16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 }
 
 func (d DelegatePair16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -708,7 +710,7 @@ func (d DelegatePair16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -19,6 +19,8 @@
 
 package generic
 
+import "git.golaxy.org/core/utils/exception"
+
 type DelegatePairVar0[VA, R1, R2 any] []FuncPairVar0[VA, R1, R2]
 
 func (d DelegatePairVar0[VA, R1, R2]) UnsafeCall(interrupt Func3[R1, R2, error, bool], args ...VA) (r1 R1, r2 R2) {
@@ -30,13 +32,13 @@ func (d DelegatePairVar0[VA, R1, R2]) SafeCall(interrupt Func3[R1, R2, error, bo
 	return d.Call(true, nil, interrupt, args...)
 }
 
-func (d DelegatePairVar0[VA, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePairVar0[VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -64,13 +66,13 @@ func (d DelegatePairVar1[A1, VA, R1, R2]) SafeCall(interrupt Func3[R1, R2, error
 	return d.Call(true, nil, interrupt, a1, args...)
 }
 
-func (d DelegatePairVar1[A1, VA, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePairVar1[A1, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -98,13 +100,13 @@ func (d DelegatePairVar2[A1, A2, VA, R1, R2]) SafeCall(interrupt Func3[R1, R2, e
 	return d.Call(true, nil, interrupt, a1, a2, args...)
 }
 
-func (d DelegatePairVar2[A1, A2, VA, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePairVar2[A1, A2, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -132,13 +134,13 @@ func (d DelegatePairVar3[A1, A2, A3, VA, R1, R2]) SafeCall(interrupt Func3[R1, R
 	return d.Call(true, nil, interrupt, a1, a2, a3, args...)
 }
 
-func (d DelegatePairVar3[A1, A2, A3, VA, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePairVar3[A1, A2, A3, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -166,13 +168,13 @@ func (d DelegatePairVar4[A1, A2, A3, A4, VA, R1, R2]) SafeCall(interrupt Func3[R
 	return d.Call(true, nil, interrupt, a1, a2, a3, a4, args...)
 }
 
-func (d DelegatePairVar4[A1, A2, A3, A4, VA, R1, R2]) Call(autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (d DelegatePairVar4[A1, A2, A3, A4, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool], a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -209,7 +211,7 @@ func (d DelegatePairVar5[A1, A2, A3, A4, A5, VA, R1, R2]) SafeCall(interrupt Fun
 }
 
 func (d DelegatePairVar5[A1, A2, A3, A4, A5, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -217,7 +219,7 @@ func (d DelegatePairVar5[A1, A2, A3, A4, A5, VA, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -254,7 +256,7 @@ func (d DelegatePairVar6[A1, A2, A3, A4, A5, A6, VA, R1, R2]) SafeCall(interrupt
 }
 
 func (d DelegatePairVar6[A1, A2, A3, A4, A5, A6, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -262,7 +264,7 @@ func (d DelegatePairVar6[A1, A2, A3, A4, A5, A6, VA, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -299,7 +301,7 @@ func (d DelegatePairVar7[A1, A2, A3, A4, A5, A6, A7, VA, R1, R2]) SafeCall(inter
 }
 
 func (d DelegatePairVar7[A1, A2, A3, A4, A5, A6, A7, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -307,7 +309,7 @@ func (d DelegatePairVar7[A1, A2, A3, A4, A5, A6, A7, VA, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -344,7 +346,7 @@ func (d DelegatePairVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R1, R2]) SafeCall(i
 }
 
 func (d DelegatePairVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -352,7 +354,7 @@ func (d DelegatePairVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -389,7 +391,7 @@ func (d DelegatePairVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R1, R2]) SafeCa
 }
 
 func (d DelegatePairVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -397,7 +399,7 @@ func (d DelegatePairVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R1, R2]) Call(
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -434,7 +436,7 @@ func (d DelegatePairVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R1, R2])
 }
 
 func (d DelegatePairVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -442,7 +444,7 @@ func (d DelegatePairVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R1, R2])
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -479,7 +481,7 @@ func (d DelegatePairVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R1,
 }
 
 func (d DelegatePairVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -487,7 +489,7 @@ func (d DelegatePairVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R1,
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -524,7 +526,7 @@ func (d DelegatePairVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA,
 }
 
 func (d DelegatePairVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -532,7 +534,7 @@ func (d DelegatePairVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA,
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -569,7 +571,7 @@ func (d DelegatePairVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegatePairVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -577,7 +579,7 @@ func (d DelegatePairVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -614,7 +616,7 @@ func (d DelegatePairVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegatePairVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -622,7 +624,7 @@ func (d DelegatePairVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -659,7 +661,7 @@ func (d DelegatePairVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegatePairVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -667,7 +669,7 @@ func (d DelegatePairVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
@@ -704,7 +706,7 @@ func (d DelegatePairVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegatePairVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error, interrupt Func3[R1, R2, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func3[R1, R2, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if len(d) <= 0 {
@@ -712,7 +714,7 @@ func (d DelegatePairVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		r1, r2, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, args...)
+		r1, r2, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, args...)
 		if interrupt.UnsafeCall(r1, r2, panicErr) {
 			return
 		}
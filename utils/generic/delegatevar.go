@@ -19,6 +19,8 @@
 
 package generic
 
+import "git.golaxy.org/core/utils/exception"
+
 type DelegateVar0[VA, R any] []FuncVar0[VA, R]
 
 func (d DelegateVar0[VA, R]) UnsafeCall(interrupt Func2[R, error, bool], args ...VA) (r R) {
@@ -30,13 +32,13 @@ func (d DelegateVar0[VA, R]) SafeCall(interrupt Func2[R, error, bool], args ...V
 	return d.Call(true, nil, interrupt, args...)
 }
 
-func (d DelegateVar0[VA, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], args ...VA) (r R, panicErr error) {
+func (d DelegateVar0[VA, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], args ...VA) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -64,13 +66,13 @@ func (d DelegateVar1[A1, VA, R]) SafeCall(interrupt Func2[R, error, bool], a1 A1
 	return d.Call(true, nil, interrupt, a1, args...)
 }
 
-func (d DelegateVar1[A1, VA, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1, args ...VA) (r R, panicErr error) {
+func (d DelegateVar1[A1, VA, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1, args ...VA) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -98,13 +100,13 @@ func (d DelegateVar2[A1, A2, VA, R]) SafeCall(interrupt Func2[R, error, bool], a
 	return d.Call(true, nil, interrupt, a1, a2, args...)
 }
 
-func (d DelegateVar2[A1, A2, VA, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1, a2 A2, args ...VA) (r R, panicErr error) {
+func (d DelegateVar2[A1, A2, VA, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1, a2 A2, args ...VA) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -132,13 +134,13 @@ func (d DelegateVar3[A1, A2, A3, VA, R]) SafeCall(interrupt Func2[R, error, bool
 	return d.Call(true, nil, interrupt, a1, a2, a3, args...)
 }
 
-func (d DelegateVar3[A1, A2, A3, VA, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3, args ...VA) (r R, panicErr error) {
+func (d DelegateVar3[A1, A2, A3, VA, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3, args ...VA) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -166,13 +168,13 @@ func (d DelegateVar4[A1, A2, A3, A4, VA, R]) SafeCall(interrupt Func2[R, error,
 	return d.Call(true, nil, interrupt, a1, a2, a3, a4, args...)
 }
 
-func (d DelegateVar4[A1, A2, A3, A4, VA, R]) Call(autoRecover bool, reportError chan error, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (r R, panicErr error) {
+func (d DelegateVar4[A1, A2, A3, A4, VA, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool], a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (r R, panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -209,7 +211,7 @@ func (d DelegateVar5[A1, A2, A3, A4, A5, VA, R]) SafeCall(interrupt Func2[R, err
 }
 
 func (d DelegateVar5[A1, A2, A3, A4, A5, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -217,7 +219,7 @@ func (d DelegateVar5[A1, A2, A3, A4, A5, VA, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -254,7 +256,7 @@ func (d DelegateVar6[A1, A2, A3, A4, A5, A6, VA, R]) SafeCall(interrupt Func2[R,
 }
 
 func (d DelegateVar6[A1, A2, A3, A4, A5, A6, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -262,7 +264,7 @@ func (d DelegateVar6[A1, A2, A3, A4, A5, A6, VA, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -299,7 +301,7 @@ func (d DelegateVar7[A1, A2, A3, A4, A5, A6, A7, VA, R]) SafeCall(interrupt Func
 }
 
 func (d DelegateVar7[A1, A2, A3, A4, A5, A6, A7, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -307,7 +309,7 @@ func (d DelegateVar7[A1, A2, A3, A4, A5, A6, A7, VA, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -344,7 +346,7 @@ func (d DelegateVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R]) SafeCall(interrupt
 }
 
 func (d DelegateVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -352,7 +354,7 @@ func (d DelegateVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -389,7 +391,7 @@ func (d DelegateVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R]) SafeCall(interr
 }
 
 func (d DelegateVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -397,7 +399,7 @@ func (d DelegateVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -434,7 +436,7 @@ func (d DelegateVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R]) SafeCall(
 }
 
 func (d DelegateVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -442,7 +444,7 @@ func (d DelegateVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R]) Call(
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -479,7 +481,7 @@ func (d DelegateVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R]) Safe
 }
 
 func (d DelegateVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -487,7 +489,7 @@ func (d DelegateVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R]) Call
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -524,7 +526,7 @@ func (d DelegateVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA, R])
 }
 
 func (d DelegateVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -532,7 +534,7 @@ func (d DelegateVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA, R])
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -569,7 +571,7 @@ func (d DelegateVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA
 }
 
 func (d DelegateVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -577,7 +579,7 @@ func (d DelegateVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -614,7 +616,7 @@ func (d DelegateVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 }
 
 func (d DelegateVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -622,7 +624,7 @@ func (d DelegateVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -659,7 +661,7 @@ func (d DelegateVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 }
 
 func (d DelegateVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -667,7 +669,7 @@ func (d DelegateVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
@@ -704,7 +706,7 @@ func (d DelegateVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 }
 
 func (d DelegateVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, VA, R]) Call(
-	autoRecover bool, reportError chan error, interrupt Func2[R, error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func2[R, error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16, args ...VA,
 ) (r R, panicErr error) {
 	if len(d) <= 0 {
@@ -712,7 +714,7 @@ func (d DelegateVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 	}
 
 	for i := range d {
-		r, panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, args...)
+		r, panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, args...)
 		if interrupt.UnsafeCall(r, panicErr) {
 			return
 		}
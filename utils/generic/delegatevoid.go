@@ -19,6 +19,8 @@
 
 package generic
 
+import "git.golaxy.org/core/utils/exception"
+
 type DelegateVoid0 []Action0
 
 func (d DelegateVoid0) UnsafeCall(interrupt Func1[error, bool]) {
@@ -29,13 +31,13 @@ func (d DelegateVoid0) SafeCall(interrupt Func1[error, bool]) (panicErr error) {
 	return d.Call(true, nil, interrupt)
 }
 
-func (d DelegateVoid0) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool]) (panicErr error) {
+func (d DelegateVoid0) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool]) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError)
+		panicErr = d[i].Call(autoRecover, reportPanic)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -62,13 +64,13 @@ func (d DelegateVoid1[A1]) SafeCall(interrupt Func1[error, bool], a1 A1) (panicE
 	return d.Call(true, nil, interrupt, a1)
 }
 
-func (d DelegateVoid1[A1]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1) (panicErr error) {
+func (d DelegateVoid1[A1]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -95,13 +97,13 @@ func (d DelegateVoid2[A1, A2]) SafeCall(interrupt Func1[error, bool], a1 A1, a2
 	return d.Call(true, nil, interrupt, a1, a2)
 }
 
-func (d DelegateVoid2[A1, A2]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1, a2 A2) (panicErr error) {
+func (d DelegateVoid2[A1, A2]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1, a2 A2) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -128,13 +130,13 @@ func (d DelegateVoid3[A1, A2, A3]) SafeCall(interrupt Func1[error, bool], a1 A1,
 	return d.Call(true, nil, interrupt, a1, a2, a3)
 }
 
-func (d DelegateVoid3[A1, A2, A3]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3) (panicErr error) {
+func (d DelegateVoid3[A1, A2, A3]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -161,13 +163,13 @@ func (d DelegateVoid4[A1, A2, A3, A4]) SafeCall(interrupt Func1[error, bool], a1
 	return d.Call(true, nil, interrupt, a1, a2, a3, a4)
 }
 
-func (d DelegateVoid4[A1, A2, A3, A4]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3, a4 A4) (panicErr error) {
+func (d DelegateVoid4[A1, A2, A3, A4]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3, a4 A4) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -201,7 +203,7 @@ func (d DelegateVoid5[A1, A2, A3, A4, A5]) SafeCall(interrupt Func1[error, bool]
 }
 
 func (d DelegateVoid5[A1, A2, A3, A4, A5]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -209,7 +211,7 @@ func (d DelegateVoid5[A1, A2, A3, A4, A5]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -243,7 +245,7 @@ func (d DelegateVoid6[A1, A2, A3, A4, A5, A6]) SafeCall(interrupt Func1[error, b
 }
 
 func (d DelegateVoid6[A1, A2, A3, A4, A5, A6]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -251,7 +253,7 @@ func (d DelegateVoid6[A1, A2, A3, A4, A5, A6]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -285,7 +287,7 @@ func (d DelegateVoid7[A1, A2, A3, A4, A5, A6, A7]) SafeCall(interrupt Func1[erro
 }
 
 func (d DelegateVoid7[A1, A2, A3, A4, A5, A6, A7]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -293,7 +295,7 @@ func (d DelegateVoid7[A1, A2, A3, A4, A5, A6, A7]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -329,7 +331,7 @@ func (d DelegateVoid8[A1, A2, A3, A4, A5, A6, A7, A8]) SafeCall(interrupt Func1[
 }
 
 func (d DelegateVoid8[A1, A2, A3, A4, A5, A6, A7, A8]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -337,7 +339,7 @@ func (d DelegateVoid8[A1, A2, A3, A4, A5, A6, A7, A8]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -373,7 +375,7 @@ func (d DelegateVoid9[A1, A2, A3, A4, A5, A6, A7, A8, A9]) SafeCall(interrupt Fu
 }
 
 func (d DelegateVoid9[A1, A2, A3, A4, A5, A6, A7, A8, A9]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -381,7 +383,7 @@ func (d DelegateVoid9[A1, A2, A3, A4, A5, A6, A7, A8, A9]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -417,7 +419,7 @@ func (d DelegateVoid10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10]) SafeCall(interr
 }
 
 func (d DelegateVoid10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -425,7 +427,7 @@ func (d DelegateVoid10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -461,7 +463,7 @@ func (d DelegateVoid11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11]) SafeCall(i
 }
 
 func (d DelegateVoid11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -469,7 +471,7 @@ func (d DelegateVoid11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -505,7 +507,7 @@ func (d DelegateVoid12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12]) SafeC
 }
 
 func (d DelegateVoid12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -513,7 +515,7 @@ func (d DelegateVoid12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -549,7 +551,7 @@ func (d DelegateVoid13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13])
 }
 
 func (d DelegateVoid13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -557,7 +559,7 @@ func (d DelegateVoid13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13])
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -593,7 +595,7 @@ func (d DelegateVoid14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 }
 
 func (d DelegateVoid14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -601,7 +603,7 @@ func (d DelegateVoid14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -637,7 +639,7 @@ func (d DelegateVoid15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 }
 
 func (d DelegateVoid15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -645,7 +647,7 @@ func (d DelegateVoid15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -681,7 +683,7 @@ func (d DelegateVoid16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 }
 
 func (d DelegateVoid16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -689,7 +691,7 @@ func (d DelegateVoid16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -19,6 +19,8 @@
 
 package generic
 
+import "git.golaxy.org/core/utils/exception"
+
 type DelegateVoidVar0[VA any] []ActionVar0[VA]
 
 func (d DelegateVoidVar0[VA]) UnsafeCall(interrupt Func1[error, bool], args ...VA) {
@@ -29,13 +31,13 @@ func (d DelegateVoidVar0[VA]) SafeCall(interrupt Func1[error, bool], args ...VA)
 	return d.Call(true, nil, interrupt, args...)
 }
 
-func (d DelegateVoidVar0[VA]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], args ...VA) (panicErr error) {
+func (d DelegateVoidVar0[VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], args ...VA) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -62,13 +64,13 @@ func (d DelegateVoidVar1[A1, VA]) SafeCall(interrupt Func1[error, bool], a1 A1,
 	return d.Call(true, nil, interrupt, a1, args...)
 }
 
-func (d DelegateVoidVar1[A1, VA]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1, args ...VA) (panicErr error) {
+func (d DelegateVoidVar1[A1, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1, args ...VA) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -95,13 +97,13 @@ func (d DelegateVoidVar2[A1, A2, VA]) SafeCall(interrupt Func1[error, bool], a1
 	return d.Call(true, nil, interrupt, a1, a2, args...)
 }
 
-func (d DelegateVoidVar2[A1, A2, VA]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1, a2 A2, args ...VA) (panicErr error) {
+func (d DelegateVoidVar2[A1, A2, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1, a2 A2, args ...VA) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -128,13 +130,13 @@ func (d DelegateVoidVar3[A1, A2, A3, VA]) SafeCall(interrupt Func1[error, bool],
 	return d.Call(true, nil, interrupt, a1, a2, a3, args...)
 }
 
-func (d DelegateVoidVar3[A1, A2, A3, VA]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3, args ...VA) (panicErr error) {
+func (d DelegateVoidVar3[A1, A2, A3, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3, args ...VA) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -161,13 +163,13 @@ func (d DelegateVoidVar4[A1, A2, A3, A4, VA]) SafeCall(interrupt Func1[error, bo
 	return d.Call(true, nil, interrupt, a1, a2, a3, a4, args...)
 }
 
-func (d DelegateVoidVar4[A1, A2, A3, A4, VA]) Call(autoRecover bool, reportError chan error, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (panicErr error) {
+func (d DelegateVoidVar4[A1, A2, A3, A4, VA]) Call(autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool], a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (panicErr error) {
 	if len(d) <= 0 {
 		return
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -201,7 +203,7 @@ func (d DelegateVoidVar5[A1, A2, A3, A4, A5, VA]) SafeCall(interrupt Func1[error
 }
 
 func (d DelegateVoidVar5[A1, A2, A3, A4, A5, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -209,7 +211,7 @@ func (d DelegateVoidVar5[A1, A2, A3, A4, A5, VA]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -245,7 +247,7 @@ func (d DelegateVoidVar6[A1, A2, A3, A4, A5, A6, VA]) SafeCall(interrupt Func1[e
 }
 
 func (d DelegateVoidVar6[A1, A2, A3, A4, A5, A6, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -253,7 +255,7 @@ func (d DelegateVoidVar6[A1, A2, A3, A4, A5, A6, VA]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -289,7 +291,7 @@ func (d DelegateVoidVar7[A1, A2, A3, A4, A5, A6, A7, VA]) SafeCall(interrupt Fun
 }
 
 func (d DelegateVoidVar7[A1, A2, A3, A4, A5, A6, A7, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -297,7 +299,7 @@ func (d DelegateVoidVar7[A1, A2, A3, A4, A5, A6, A7, VA]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -333,7 +335,7 @@ func (d DelegateVoidVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA]) SafeCall(interrupt
 }
 
 func (d DelegateVoidVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -341,7 +343,7 @@ func (d DelegateVoidVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -377,7 +379,7 @@ func (d DelegateVoidVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA]) SafeCall(inter
 }
 
 func (d DelegateVoidVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -385,7 +387,7 @@ func (d DelegateVoidVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -421,7 +423,7 @@ func (d DelegateVoidVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA]) SafeCall
 }
 
 func (d DelegateVoidVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -429,7 +431,7 @@ func (d DelegateVoidVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA]) Call(
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -465,7 +467,7 @@ func (d DelegateVoidVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA]) Saf
 }
 
 func (d DelegateVoidVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -473,7 +475,7 @@ func (d DelegateVoidVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA]) Cal
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -509,7 +511,7 @@ func (d DelegateVoidVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA]
 }
 
 func (d DelegateVoidVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -517,7 +519,7 @@ func (d DelegateVoidVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA]
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -553,7 +555,7 @@ func (d DelegateVoidVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegateVoidVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -561,7 +563,7 @@ func (d DelegateVoidVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -597,7 +599,7 @@ func (d DelegateVoidVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegateVoidVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -605,7 +607,7 @@ func (d DelegateVoidVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -641,7 +643,7 @@ func (d DelegateVoidVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegateVoidVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -649,7 +651,7 @@ func (d DelegateVoidVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -685,7 +687,7 @@ func (d DelegateVoidVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 }
 
 func (d DelegateVoidVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, VA]) Call(
-	autoRecover bool, reportError chan error, interrupt Func1[error, bool],
+	autoRecover bool, reportPanic exception.PanicReporter, interrupt Func1[error, bool],
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16, args ...VA,
 ) (panicErr error) {
 	if len(d) <= 0 {
@@ -693,7 +695,7 @@ func (d DelegateVoidVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13
 	}
 
 	for i := range d {
-		panicErr = d[i].Call(autoRecover, reportError, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, args...)
+		panicErr = d[i].Call(autoRecover, reportPanic, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, args...)
 		if interrupt.UnsafeCall(panicErr) {
 			return
 		}
@@ -37,7 +37,7 @@ func (f Func0[R]) SafeCall() (r R, panicErr error) {
 	return f.Call(true, nil)
 }
 
-func (f Func0[R]) Call(autoRecover bool, reportError chan error) (r R, panicErr error) {
+func (f Func0[R]) Call(autoRecover bool, reportPanic exception.PanicReporter) (r R, panicErr error) {
 	if f == nil {
 		return types.Zero[R](), nil
 	}
@@ -45,10 +45,7 @@ func (f Func0[R]) Call(autoRecover bool, reportError chan error) (r R, panicErr
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -71,7 +68,7 @@ func (f Func1[A1, R]) SafeCall(a1 A1) (r R, panicErr error) {
 	return f.Call(true, nil, a1)
 }
 
-func (f Func1[A1, R]) Call(autoRecover bool, reportError chan error, a1 A1) (r R, panicErr error) {
+func (f Func1[A1, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1) (r R, panicErr error) {
 	if f == nil {
 		return types.Zero[R](), nil
 	}
@@ -79,10 +76,7 @@ func (f Func1[A1, R]) Call(autoRecover bool, reportError chan error, a1 A1) (r R
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -105,7 +99,7 @@ func (f Func2[A1, A2, R]) SafeCall(a1 A1, a2 A2) (r R, panicErr error) {
 	return f.Call(true, nil, a1, a2)
 }
 
-func (f Func2[A1, A2, R]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2) (r R, panicErr error) {
+func (f Func2[A1, A2, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2) (r R, panicErr error) {
 	if f == nil {
 		return types.Zero[R](), nil
 	}
@@ -113,10 +107,7 @@ func (f Func2[A1, A2, R]) Call(autoRecover bool, reportError chan error, a1 A1,
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -139,7 +130,7 @@ func (f Func3[A1, A2, A3, R]) SafeCall(a1 A1, a2 A2, a3 A3) (r R, panicErr error
 	return f.Call(true, nil, a1, a2, a3)
 }
 
-func (f Func3[A1, A2, A3, R]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3) (r R, panicErr error) {
+func (f Func3[A1, A2, A3, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3) (r R, panicErr error) {
 	if f == nil {
 		return types.Zero[R](), nil
 	}
@@ -147,10 +138,7 @@ func (f Func3[A1, A2, A3, R]) Call(autoRecover bool, reportError chan error, a1
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -173,7 +161,7 @@ func (f Func4[A1, A2, A3, A4, R]) SafeCall(a1 A1, a2 A2, a3 A3, a4 A4) (r R, pan
 	return f.Call(true, nil, a1, a2, a3, a4)
 }
 
-func (f Func4[A1, A2, A3, A4, R]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3, a4 A4) (r R, panicErr error) {
+func (f Func4[A1, A2, A3, A4, R]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3, a4 A4) (r R, panicErr error) {
 	if f == nil {
 		return types.Zero[R](), nil
 	}
@@ -181,10 +169,7 @@ func (f Func4[A1, A2, A3, A4, R]) Call(autoRecover bool, reportError chan error,
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -214,7 +199,7 @@ func (f Func5[A1, A2, A3, A4, A5, R]) SafeCall(
 }
 
 func (f Func5[A1, A2, A3, A4, A5, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -224,10 +209,7 @@ func (f Func5[A1, A2, A3, A4, A5, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -257,7 +239,7 @@ func (f Func6[A1, A2, A3, A4, A5, A6, R]) SafeCall(
 }
 
 func (f Func6[A1, A2, A3, A4, A5, A6, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -267,10 +249,7 @@ func (f Func6[A1, A2, A3, A4, A5, A6, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -300,7 +279,7 @@ func (f Func7[A1, A2, A3, A4, A5, A6, A7, R]) SafeCall(
 }
 
 func (f Func7[A1, A2, A3, A4, A5, A6, A7, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -310,10 +289,7 @@ func (f Func7[A1, A2, A3, A4, A5, A6, A7, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -343,7 +319,7 @@ func (f Func8[A1, A2, A3, A4, A5, A6, A7, A8, R]) SafeCall(
 }
 
 func (f Func8[A1, A2, A3, A4, A5, A6, A7, A8, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -353,10 +329,7 @@ func (f Func8[A1, A2, A3, A4, A5, A6, A7, A8, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -386,7 +359,7 @@ func (f Func9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R]) SafeCall(
 }
 
 func (f Func9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -396,10 +369,7 @@ func (f Func9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -429,7 +399,7 @@ func (f Func10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R]) SafeCall(
 }
 
 func (f Func10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -439,10 +409,7 @@ func (f Func10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -472,7 +439,7 @@ func (f Func11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R]) SafeCall(
 }
 
 func (f Func11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -482,10 +449,7 @@ func (f Func11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -515,7 +479,7 @@ func (f Func12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R]) SafeCall(
 }
 
 func (f Func12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -525,10 +489,7 @@ func (f Func12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -558,7 +519,7 @@ func (f Func13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R]) SafeC
 }
 
 func (f Func13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -568,10 +529,7 @@ func (f Func13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -601,7 +559,7 @@ func (f Func14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, R])
 }
 
 func (f Func14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -611,10 +569,7 @@ func (f Func14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, R])
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -644,7 +599,7 @@ func (f Func15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15,
 }
 
 func (f Func15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -654,10 +609,7 @@ func (f Func15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15,
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -687,7 +639,7 @@ func (f Func16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15,
 }
 
 func (f Func16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, R]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16,
 ) (r R, panicErr error) {
 	if f == nil {
@@ -697,10 +649,7 @@ func (f Func16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15,
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -37,7 +37,7 @@ func (f FuncPair0[R1, R2]) SafeCall() (r1 R1, r2 R2, panicErr error) {
 	return f.Call(true, nil)
 }
 
-func (f FuncPair0[R1, R2]) Call(autoRecover bool, reportError chan error) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPair0[R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -45,10 +45,7 @@ func (f FuncPair0[R1, R2]) Call(autoRecover bool, reportError chan error) (r1 R1
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -72,7 +69,7 @@ func (f FuncPair1[A1, R1, R2]) SafeCall(a1 A1) (r1 R1, r2 R2, panicErr error) {
 	return f.Call(true, nil, a1)
 }
 
-func (f FuncPair1[A1, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPair1[A1, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -80,10 +77,7 @@ func (f FuncPair1[A1, R1, R2]) Call(autoRecover bool, reportError chan error, a1
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -107,7 +101,7 @@ func (f FuncPair2[A1, A2, R1, R2]) SafeCall(a1 A1, a2 A2) (r1 R1, r2 R2, panicEr
 	return f.Call(true, nil, a1, a2)
 }
 
-func (f FuncPair2[A1, A2, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPair2[A1, A2, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -115,10 +109,7 @@ func (f FuncPair2[A1, A2, R1, R2]) Call(autoRecover bool, reportError chan error
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -142,7 +133,7 @@ func (f FuncPair3[A1, A2, A3, R1, R2]) SafeCall(a1 A1, a2 A2, a3 A3) (r1 R1, r2
 	return f.Call(true, nil, a1, a2, a3)
 }
 
-func (f FuncPair3[A1, A2, A3, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPair3[A1, A2, A3, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -150,10 +141,7 @@ func (f FuncPair3[A1, A2, A3, R1, R2]) Call(autoRecover bool, reportError chan e
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -177,7 +165,7 @@ func (f FuncPair4[A1, A2, A3, A4, R1, R2]) SafeCall(a1 A1, a2 A2, a3 A3, a4 A4)
 	return f.Call(true, nil, a1, a2, a3, a4)
 }
 
-func (f FuncPair4[A1, A2, A3, A4, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPair4[A1, A2, A3, A4, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -185,10 +173,7 @@ func (f FuncPair4[A1, A2, A3, A4, R1, R2]) Call(autoRecover bool, reportError ch
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -219,7 +204,7 @@ func (f FuncPair5[A1, A2, A3, A4, A5, R1, R2]) SafeCall(
 }
 
 func (f FuncPair5[A1, A2, A3, A4, A5, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -229,10 +214,7 @@ func (f FuncPair5[A1, A2, A3, A4, A5, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -263,7 +245,7 @@ func (f FuncPair6[A1, A2, A3, A4, A5, A6, R1, R2]) SafeCall(
 }
 
 func (f FuncPair6[A1, A2, A3, A4, A5, A6, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -273,10 +255,7 @@ func (f FuncPair6[A1, A2, A3, A4, A5, A6, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -307,7 +286,7 @@ func (f FuncPair7[A1, A2, A3, A4, A5, A6, A7, R1, R2]) SafeCall(
 }
 
 func (f FuncPair7[A1, A2, A3, A4, A5, A6, A7, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -317,10 +296,7 @@ func (f FuncPair7[A1, A2, A3, A4, A5, A6, A7, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -351,7 +327,7 @@ func (f FuncPair8[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2]) SafeCall(
 }
 
 func (f FuncPair8[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -361,10 +337,7 @@ func (f FuncPair8[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -395,7 +368,7 @@ func (f FuncPair9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2]) SafeCall(
 }
 
 func (f FuncPair9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -405,10 +378,7 @@ func (f FuncPair9[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -439,7 +409,7 @@ func (f FuncPair10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R1, R2]) SafeCall(
 }
 
 func (f FuncPair10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -449,10 +419,7 @@ func (f FuncPair10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -483,7 +450,7 @@ func (f FuncPair11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R1, R2]) SafeCa
 }
 
 func (f FuncPair11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -493,10 +460,7 @@ func (f FuncPair11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -527,7 +491,7 @@ func (f FuncPair12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R1, R2]) S
 }
 
 func (f FuncPair12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -537,10 +501,7 @@ func (f FuncPair12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, R1, R2]) C
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -571,7 +532,7 @@ func (f FuncPair13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R1, R
 }
 
 func (f FuncPair13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -581,10 +542,7 @@ func (f FuncPair13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, R1, R
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -615,7 +573,7 @@ func (f FuncPair14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (f FuncPair14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -625,10 +583,7 @@ func (f FuncPair14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -659,7 +614,7 @@ func (f FuncPair15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (f FuncPair15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -671,12 +626,7 @@ func (f FuncPair15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -707,7 +657,7 @@ func (f FuncPair16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 }
 
 func (f FuncPair16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -719,12 +669,7 @@ func (f FuncPair16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14,
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -37,7 +37,7 @@ func (f FuncPairVar0[VA, R1, R2]) SafeCall(args ...VA) (r1 R1, r2 R2, panicErr e
 	return f.Call(true, nil, args...)
 }
 
-func (f FuncPairVar0[VA, R1, R2]) Call(autoRecover bool, reportError chan error, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPairVar0[VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -45,10 +45,7 @@ func (f FuncPairVar0[VA, R1, R2]) Call(autoRecover bool, reportError chan error,
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -72,7 +69,7 @@ func (f FuncPairVar1[A1, VA, R1, R2]) SafeCall(a1 A1, args ...VA) (r1 R1, r2 R2,
 	return f.Call(true, nil, a1, args...)
 }
 
-func (f FuncPairVar1[A1, VA, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPairVar1[A1, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -80,10 +77,7 @@ func (f FuncPairVar1[A1, VA, R1, R2]) Call(autoRecover bool, reportError chan er
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -107,7 +101,7 @@ func (f FuncPairVar2[A1, A2, VA, R1, R2]) SafeCall(a1 A1, a2 A2, args ...VA) (r1
 	return f.Call(true, nil, a1, a2, args...)
 }
 
-func (f FuncPairVar2[A1, A2, VA, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPairVar2[A1, A2, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -115,10 +109,7 @@ func (f FuncPairVar2[A1, A2, VA, R1, R2]) Call(autoRecover bool, reportError cha
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -142,7 +133,7 @@ func (f FuncPairVar3[A1, A2, A3, VA, R1, R2]) SafeCall(a1 A1, a2 A2, a3 A3, args
 	return f.Call(true, nil, a1, a2, a3, args...)
 }
 
-func (f FuncPairVar3[A1, A2, A3, VA, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPairVar3[A1, A2, A3, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -150,10 +141,7 @@ func (f FuncPairVar3[A1, A2, A3, VA, R1, R2]) Call(autoRecover bool, reportError
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -177,7 +165,7 @@ func (f FuncPairVar4[A1, A2, A3, A4, VA, R1, R2]) SafeCall(a1 A1, a2 A2, a3 A3,
 	return f.Call(true, nil, a1, a2, a3, a4, args...)
 }
 
-func (f FuncPairVar4[A1, A2, A3, A4, VA, R1, R2]) Call(autoRecover bool, reportError chan error, a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (r1 R1, r2 R2, panicErr error) {
+func (f FuncPairVar4[A1, A2, A3, A4, VA, R1, R2]) Call(autoRecover bool, reportPanic exception.PanicReporter, a1 A1, a2 A2, a3 A3, a4 A4, args ...VA) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
 		return types.Zero[R1](), types.Zero[R2](), nil
 	}
@@ -185,10 +173,7 @@ func (f FuncPairVar4[A1, A2, A3, A4, VA, R1, R2]) Call(autoRecover bool, reportE
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -219,7 +204,7 @@ func (f FuncPairVar5[A1, A2, A3, A4, A5, VA, R1, R2]) SafeCall(
 }
 
 func (f FuncPairVar5[A1, A2, A3, A4, A5, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -229,10 +214,7 @@ func (f FuncPairVar5[A1, A2, A3, A4, A5, VA, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -263,7 +245,7 @@ func (f FuncPairVar6[A1, A2, A3, A4, A5, A6, VA, R1, R2]) SafeCall(
 }
 
 func (f FuncPairVar6[A1, A2, A3, A4, A5, A6, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -273,10 +255,7 @@ func (f FuncPairVar6[A1, A2, A3, A4, A5, A6, VA, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -307,7 +286,7 @@ func (f FuncPairVar7[A1, A2, A3, A4, A5, A6, A7, VA, R1, R2]) SafeCall(
 }
 
 func (f FuncPairVar7[A1, A2, A3, A4, A5, A6, A7, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -317,10 +296,7 @@ func (f FuncPairVar7[A1, A2, A3, A4, A5, A6, A7, VA, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -351,7 +327,7 @@ func (f FuncPairVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R1, R2]) SafeCall(
 }
 
 func (f FuncPairVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -361,10 +337,7 @@ func (f FuncPairVar8[A1, A2, A3, A4, A5, A6, A7, A8, VA, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -395,7 +368,7 @@ func (f FuncPairVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R1, R2]) SafeCall(
 }
 
 func (f FuncPairVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -405,10 +378,7 @@ func (f FuncPairVar9[A1, A2, A3, A4, A5, A6, A7, A8, A9, VA, R1, R2]) Call(
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -439,7 +409,7 @@ func (f FuncPairVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R1, R2]) Safe
 }
 
 func (f FuncPairVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -449,10 +419,7 @@ func (f FuncPairVar10[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, VA, R1, R2]) Call
 	if autoRecover {
 		defer func() {
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
-				select {
-				case reportError <- exception.TraceStack(fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)):
-				default:
-				}
+				exception.ReportPanic(reportPanic, fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr))
 			}
 		}()
 	}
@@ -483,7 +450,7 @@ func (f FuncPairVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R1, R2])
 }
 
 func (f FuncPairVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -495,12 +462,7 @@ func (f FuncPairVar11[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, VA, R1, R2])
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -531,7 +493,7 @@ func (f FuncPairVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA, R1,
 }
 
 func (f FuncPairVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -543,12 +505,7 @@ func (f FuncPairVar12[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, VA, R1,
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -579,7 +536,7 @@ func (f FuncPairVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA
 }
 
 func (f FuncPairVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -591,12 +548,7 @@ func (f FuncPairVar13[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, VA
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -627,7 +579,7 @@ func (f FuncPairVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 }
 
 func (f FuncPairVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -639,12 +591,7 @@ func (f FuncPairVar14[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -675,7 +622,7 @@ func (f FuncPairVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 }
 
 func (f FuncPairVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -687,12 +634,7 @@ func (f FuncPairVar15[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}
@@ -723,7 +665,7 @@ func (f FuncPairVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 }
 
 func (f FuncPairVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A14, A15, A16, VA, R1, R2]) Call(
-	autoRecover bool, reportError chan error,
+	autoRecover bool, reportPanic exception.PanicReporter,
 	a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9, a10 A10, a11 A11, a12 A12, a13 A13, a14 A14, a15 A15, a16 A16, args ...VA,
 ) (r1 R1, r2 R2, panicErr error) {
 	if f == nil {
@@ -735,12 +677,7 @@ func (f FuncPairVar16[A1, A2, A3, A4, A5, A6, A7, A8, A9, A10, A11, A12, A13, A1
 			if panicErr = types.Panic2Err(recover()); panicErr != nil {
 				panicErr = fmt.Errorf("%w: %w", exception.ErrPanicked, panicErr)
 
-				if reportError != nil {
-					select {
-					case reportError <- exception.TraceStack(panicErr):
-					default:
-					}
-				}
+				exception.ReportPanic(reportPanic, panicErr)
 			}
 		}()
 	}